@@ -0,0 +1,473 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// EtherDecimals is the number of decimal places between Wei and Ether
+const EtherDecimals = 18
+
+// RoundingMode defines how a value is rounded when precision is lost
+type RoundingMode int
+
+const (
+	// RoundDown rounds towards zero (truncation)
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundHalfUp rounds to the nearest value, with ties away from zero
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest value, with ties to the even value
+	RoundHalfEven
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+)
+
+var ten = big.NewInt(10)
+
+var errExcessPrecision = errors.New("Value has too many decimal places")
+
+// Amount is a fixed-point decimal amount of a token.  Value holds the number
+// of the token's smallest unit, so an amount of 1.5 for a token with 6
+// decimals has a value of 1500000
+type Amount struct {
+	Value    *big.Int
+	Decimals int
+	Symbol   string
+}
+
+// NewAmount creates an amount from a value in the token's smallest unit
+func NewAmount(value *big.Int, decimals int, symbol string) *Amount {
+	return &Amount{
+		Value:    new(big.Int).Set(value),
+		Decimals: decimals,
+		Symbol:   symbol,
+	}
+}
+
+// NewEtherAmount creates an amount of Ether from a number of Wei
+func NewEtherAmount(wei *big.Int) *Amount {
+	return NewAmount(wei, EtherDecimals, "Ether")
+}
+
+// ParseAmount parses a decimal string such as "1.5" or "1.5 USDC" in to an
+// amount of a token with the given number of decimals.  If the input carries
+// a symbol it must match the supplied symbol, ignoring case.  It is an error
+// for the input to have more decimal places than the token
+func ParseAmount(input string, decimals int, symbol string) (*Amount, error) {
+	if decimals < 0 {
+		return nil, fmt.Errorf("Invalid number of decimals %d", decimals)
+	}
+	number := strings.TrimSpace(input)
+	if symbol != "" && len(number) > len(symbol) && strings.EqualFold(number[len(number)-len(symbol):], symbol) {
+		number = strings.TrimSpace(number[:len(number)-len(symbol)])
+	}
+	value, err := parseFixed(number, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %v", input, err)
+	}
+	return &Amount{Value: value, Decimals: decimals, Symbol: symbol}, nil
+}
+
+// Text returns the amount as a decimal string without a symbol, for example
+// "1.5".  Trailing zeros in the fractional part are removed
+func (a *Amount) Text() string {
+	return formatFixed(a.Value, a.Decimals)
+}
+
+// FixedText returns the amount as a decimal string showing all of its
+// decimal places, for example "1.50" for an amount with two decimals
+func (a *Amount) FixedText() string {
+	text := a.Text()
+	if a.Decimals == 0 {
+		return text
+	}
+	places := 0
+	if pos := strings.IndexByte(text, '.'); pos == -1 {
+		text = text + "."
+	} else {
+		places = len(text) - pos - 1
+	}
+	return text + strings.Repeat("0", a.Decimals-places)
+}
+
+// String returns the amount as a decimal string followed by its symbol, if
+// any, for example "1.5 USDC"
+func (a *Amount) String() string {
+	if a.Symbol == "" {
+		return a.Text()
+	}
+	return fmt.Sprintf("%s %s", a.Text(), a.Symbol)
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount
+func (a *Amount) Sign() int {
+	return a.Value.Sign()
+}
+
+// Cmp compares two amounts, returning -1, 0 or +1 if a is less than, equal
+// to or greater than b.  Amounts with different decimals are compared exactly
+func (a *Amount) Cmp(b *Amount) int {
+	if a.Decimals == b.Decimals {
+		return a.Value.Cmp(b.Value)
+	}
+	if a.Decimals > b.Decimals {
+		return a.Value.Cmp(scaleUp(b.Value, a.Decimals-b.Decimals))
+	}
+	return scaleUp(a.Value, b.Decimals-a.Decimals).Cmp(b.Value)
+}
+
+// Add returns the sum of two amounts.  Both amounts must have the same
+// decimals and, if set, the same symbol
+func (a *Amount) Add(b *Amount) (*Amount, error) {
+	symbol, err := a.compatible(b)
+	if err != nil {
+		return nil, err
+	}
+	return &Amount{Value: new(big.Int).Add(a.Value, b.Value), Decimals: a.Decimals, Symbol: symbol}, nil
+}
+
+// Sub returns the difference of two amounts.  Both amounts must have the same
+// decimals and, if set, the same symbol
+func (a *Amount) Sub(b *Amount) (*Amount, error) {
+	symbol, err := a.compatible(b)
+	if err != nil {
+		return nil, err
+	}
+	return &Amount{Value: new(big.Int).Sub(a.Value, b.Value), Decimals: a.Decimals, Symbol: symbol}, nil
+}
+
+// Mul multiplies the amount by a decimal factor, for example a price or a
+// percentage.  The result keeps the decimals and symbol of a and is rounded
+// using the supplied mode
+func (a *Amount) Mul(factor *Amount, mode RoundingMode) *Amount {
+	value := new(big.Int).Mul(a.Value, factor.Value)
+	return &Amount{Value: divRound(value, pow10(factor.Decimals), mode), Decimals: a.Decimals, Symbol: a.Symbol}
+}
+
+// Div divides the amount by a decimal divisor.  The result keeps the decimals
+// and symbol of a and is rounded using the supplied mode
+func (a *Amount) Div(divisor *Amount, mode RoundingMode) (*Amount, error) {
+	if divisor.Value.Sign() == 0 {
+		return nil, errors.New("Division by zero")
+	}
+	value := scaleUp(a.Value, divisor.Decimals)
+	return &Amount{Value: divRound(value, divisor.Value, mode), Decimals: a.Decimals, Symbol: a.Symbol}, nil
+}
+
+// Rescale returns the amount with a different number of decimals, rounding
+// using the supplied mode if precision is lost
+func (a *Amount) Rescale(decimals int, mode RoundingMode) *Amount {
+	var value *big.Int
+	if decimals >= a.Decimals {
+		value = scaleUp(a.Value, decimals-a.Decimals)
+	} else {
+		value = divRound(a.Value, pow10(a.Decimals-decimals), mode)
+	}
+	return &Amount{Value: value, Decimals: decimals, Symbol: a.Symbol}
+}
+
+// compatible checks that two amounts can be added together, returning the
+// symbol of the result
+func (a *Amount) compatible(b *Amount) (string, error) {
+	if a.Decimals != b.Decimals {
+		return "", fmt.Errorf("Mismatched decimals %d and %d", a.Decimals, b.Decimals)
+	}
+	if a.Symbol != "" && b.Symbol != "" && !strings.EqualFold(a.Symbol, b.Symbol) {
+		return "", fmt.Errorf("Mismatched symbols %s and %s", a.Symbol, b.Symbol)
+	}
+	if a.Symbol == "" {
+		return b.Symbol, nil
+	}
+	return a.Symbol, nil
+}
+
+// Cached powers of ten, used by pow10
+var powersOfTen = func() []*big.Int {
+	powers := make([]*big.Int, 80)
+	powers[0] = big.NewInt(1)
+	for i := 1; i < len(powers); i++ {
+		powers[i] = new(big.Int).Mul(powers[i-1], ten)
+	}
+	return powers
+}()
+
+// pow10 returns 10^n.  The result can be shared and must not be modified
+func pow10(n int) *big.Int {
+	if n >= 0 && n < len(powersOfTen) {
+		return powersOfTen[n]
+	}
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+// scaleUp returns value * 10^n
+func scaleUp(value *big.Int, n int) *big.Int {
+	return new(big.Int).Mul(value, pow10(n))
+}
+
+// divRound returns num / den rounded using the supplied mode
+func divRound(num *big.Int, den *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// Quotient is truncated towards zero; work out which way is away from it
+	negative := (num.Sign() < 0) != (den.Sign() < 0)
+	away := big.NewInt(1)
+	if negative {
+		away.SetInt64(-1)
+	}
+
+	increment := false
+	switch mode {
+	case RoundUp:
+		increment = true
+	case RoundFloor:
+		increment = negative
+	case RoundCeiling:
+		increment = !negative
+	case RoundHalfUp, RoundHalfEven:
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+		cmp := half.Cmp(new(big.Int).Abs(den))
+		increment = cmp > 0 || (cmp == 0 && (mode == RoundHalfUp || quo.Bit(0) == 1))
+	}
+	if increment {
+		quo.Add(quo, away)
+	}
+	return quo
+}
+
+// parseFixed parses a plain unsigned decimal string such as "1.5" in to an
+// integer with the given number of decimal places
+func parseFixed(number string, decimals int) (*big.Int, error) {
+	value := new(big.Int)
+	if err := setFixed(value, number, decimals); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// setFixed is parseFixed storing the result in z.  Values below 2^128 are
+// built in z's own storage, so do not allocate if z has room for them
+func setFixed(z *big.Int, number string, decimals int) error {
+	integer := number
+	fraction := ""
+	if pos := strings.IndexByte(number, '.'); pos != -1 {
+		integer = number[:pos]
+		fraction = number[pos+1:]
+	}
+	if integer == "" && fraction == "" {
+		return errors.New("Missing numeric value")
+	}
+	for _, part := range [2]string{integer, fraction} {
+		for i := 0; i < len(part); i++ {
+			if !isDigit(part[i]) {
+				return fmt.Errorf("Invalid character %q", part[i])
+			}
+		}
+	}
+
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > decimals {
+		return errExcessPrecision
+	}
+	if len(integer)+len(fraction) <= 19 {
+		// Small enough to build without an intermediate string
+		var small uint64
+		for _, part := range [2]string{integer, fraction} {
+			for i := 0; i < len(part); i++ {
+				small = small*10 + uint64(part[i]-'0')
+			}
+		}
+		if hi, lo, ok := scaleUint128(small, decimals-len(fraction)); ok {
+			setUint128(z, hi, lo)
+			return nil
+		}
+		z.SetUint64(small)
+	} else {
+		z.SetString(integer+fraction, 10)
+	}
+	if z.Sign() != 0 && decimals > len(fraction) {
+		z.Mul(z, pow10(decimals-len(fraction)))
+	}
+	return nil
+}
+
+// uint64Powers holds the powers of ten that fit in a uint64
+var uint64Powers = func() [20]uint64 {
+	var powers [20]uint64
+	powers[0] = 1
+	for i := 1; i < len(powers); i++ {
+		powers[i] = powers[i-1] * 10
+	}
+	return powers
+}()
+
+// scaleUint128 returns value * 10^n as a 128-bit number, or false if it
+// does not fit
+func scaleUint128(value uint64, n int) (hi uint64, lo uint64, ok bool) {
+	lo = value
+	for n > 0 && (hi != 0 || lo != 0) {
+		step := n
+		if step >= len(uint64Powers) {
+			step = len(uint64Powers) - 1
+		}
+		n -= step
+		loHi, loLo := bits.Mul64(lo, uint64Powers[step])
+		hiHi, hiLo := bits.Mul64(hi, uint64Powers[step])
+		var carry uint64
+		hi, carry = bits.Add64(loHi, hiLo, 0)
+		if hiHi != 0 || carry != 0 {
+			return 0, 0, false
+		}
+		lo = loLo
+	}
+	return hi, lo, true
+}
+
+// setUint128 sets z to the 128-bit number hi:lo, reusing z's storage
+func setUint128(z *big.Int, hi uint64, lo uint64) {
+	words := z.Bits()
+	if cap(words) < 128/bits.UintSize {
+		words = make([]big.Word, 128/bits.UintSize)
+	}
+	words = words[:0]
+	for _, half := range [2]uint64{lo, hi} {
+		for shift := 0; shift < 64; shift += bits.UintSize {
+			words = append(words, big.Word(half>>uint(shift)))
+		}
+	}
+	z.SetBits(words)
+}
+
+// maxFastWords is the largest number of words in a value that appendDecimal
+// formats without allocating
+const maxFastWords = 16
+
+// decimalChunk is the largest power of ten that fits in a word, and
+// chunkDigits the number of zeros in it
+const (
+	chunkDigits        = 9 + 10*(bits.UintSize/64)
+	decimalChunk  uint = 1e9 * (1 + (1e10-1)*(bits.UintSize/64))
+	maxFastChunks      = 2 * maxFastWords
+)
+
+// appendDecimal appends the decimal digits of a value, with a leading '-'
+// if negative, to dst.  It is value.Append(dst, 10) without the allocation
+// that it makes, for values of up to maxFastWords words
+func appendDecimal(dst []byte, value *big.Int) []byte {
+	words := value.Bits()
+	if len(words) > maxFastWords {
+		return value.Append(dst, 10)
+	}
+	if value.Sign() < 0 {
+		dst = append(dst, '-')
+	}
+	if len(words) == 0 {
+		return append(dst, '0')
+	}
+
+	// Divide a copy of the value by decimalChunk repeatedly, collecting the
+	// remainders from least to most significant
+	var quotient [maxFastWords]uint
+	for i, word := range words {
+		quotient[i] = uint(word)
+	}
+	n := len(words)
+	var chunks [maxFastChunks]uint
+	count := 0
+	for n > 0 {
+		var rem uint
+		for i := n - 1; i >= 0; i-- {
+			quotient[i], rem = bits.Div(rem, quotient[i], decimalChunk)
+		}
+		chunks[count] = rem
+		count++
+		for n > 0 && quotient[n-1] == 0 {
+			n--
+		}
+	}
+
+	dst = strconv.AppendUint(dst, uint64(chunks[count-1]), 10)
+	for i := count - 2; i >= 0; i-- {
+		var digits [chunkDigits]byte
+		chunk := chunks[i]
+		for j := chunkDigits - 1; j >= 0; j-- {
+			digits[j] = byte('0' + chunk%10)
+			chunk /= 10
+		}
+		dst = append(dst, digits[:]...)
+	}
+	return dst
+}
+
+// formatFixed formats an integer with the given number of decimal places as
+// a plain decimal string, removing trailing zeros from the fractional part
+func formatFixed(value *big.Int, decimals int) string {
+	return string(placeDecimal(appendDecimal(nil, value), 0, decimals))
+}
+
+// placeDecimal places a decimal point in the integer digits held in
+// buf[start:] so that they have the given number of decimal places, then
+// removes trailing zeros from the fractional part.  The digits may be
+// preceded by a '-' sign
+func placeDecimal(buf []byte, start int, decimals int) []byte {
+	if decimals <= 0 {
+		return buf
+	}
+	if buf[start] == '-' {
+		start++
+	}
+	length := len(buf) - start
+	if length <= decimals {
+		// Need leading zeros; shift the digits right to make room for them
+		shift := decimals - length + 1
+		for i := 0; i < shift; i++ {
+			buf = append(buf, 0)
+		}
+		copy(buf[start+shift:], buf[start:start+length])
+		for i := 0; i < shift; i++ {
+			buf[start+i] = '0'
+		}
+	}
+
+	// Trim trailing zeros from the fractional part
+	point := len(buf) - decimals
+	end := len(buf)
+	for end > point && buf[end-1] == '0' {
+		end--
+	}
+	buf = buf[:end]
+	if end == point {
+		return buf
+	}
+
+	// Insert the decimal point
+	buf = append(buf, 0)
+	copy(buf[point+1:], buf[point:end])
+	buf[point] = '.'
+	return buf
+}
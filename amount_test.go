@@ -0,0 +1,171 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmountSixDecimals(t *testing.T) {
+	result, err := ParseAmount("1.5 USDC", 6, "USDC")
+	assert.Nil(t, err, "Failed to parse amount")
+	assert.Equal(t, big.NewInt(1500000), result.Value, "Did not receive expected result")
+	assert.Equal(t, "1.5 USDC", result.String(), "Did not receive expected result")
+}
+
+func TestParseAmountNoSymbol(t *testing.T) {
+	result, err := ParseAmount("0.00000001", 8, "WBTC")
+	assert.Nil(t, err, "Failed to parse amount")
+	assert.Equal(t, big.NewInt(1), result.Value, "Did not receive expected result")
+}
+
+func TestParseAmountZeroDecimals(t *testing.T) {
+	result, err := ParseAmount("42", 0, "")
+	assert.Nil(t, err, "Failed to parse amount")
+	assert.Equal(t, big.NewInt(42), result.Value, "Did not receive expected result")
+	assert.Equal(t, "42", result.String(), "Did not receive expected result")
+}
+
+func TestParseAmountExcessPrecision(t *testing.T) {
+	_, err := ParseAmount("1.0000001", 6, "USDC")
+	assert.NotNil(t, err, "Parsed amount with too many decimals")
+	_, err = ParseAmount("1.5", 0, "")
+	assert.NotNil(t, err, "Parsed amount with too many decimals")
+}
+
+func TestParseAmountWrongSymbol(t *testing.T) {
+	_, err := ParseAmount("1.5 DAI", 6, "USDC")
+	assert.NotNil(t, err, "Parsed amount with wrong symbol")
+}
+
+func TestAmountText(t *testing.T) {
+	assert.Equal(t, "0", NewAmount(big.NewInt(0), 6, "").Text(), "Did not receive expected result")
+	assert.Equal(t, "0.000001", NewAmount(big.NewInt(1), 6, "").Text(), "Did not receive expected result")
+	assert.Equal(t, "-0.25", NewAmount(big.NewInt(-250000), 6, "").Text(), "Did not receive expected result")
+	assert.Equal(t, "12", NewAmount(big.NewInt(12000000), 6, "").Text(), "Did not receive expected result")
+}
+
+func TestAmountAddSub(t *testing.T) {
+	a, _ := ParseAmount("1.5", 6, "USDC")
+	b, _ := ParseAmount("2.25", 6, "USDC")
+	sum, err := a.Add(b)
+	assert.Nil(t, err, "Failed to add amounts")
+	assert.Equal(t, "3.75 USDC", sum.String(), "Did not receive expected result")
+	diff, err := a.Sub(b)
+	assert.Nil(t, err, "Failed to subtract amounts")
+	assert.Equal(t, "-0.75 USDC", diff.String(), "Did not receive expected result")
+}
+
+func TestAmountAddMismatched(t *testing.T) {
+	a, _ := ParseAmount("1.5", 6, "USDC")
+	b, _ := ParseAmount("1.5", 8, "WBTC")
+	_, err := a.Add(b)
+	assert.NotNil(t, err, "Added mismatched amounts")
+	c, _ := ParseAmount("1.5", 6, "USDT")
+	_, err = a.Add(c)
+	assert.NotNil(t, err, "Added mismatched amounts")
+}
+
+func TestAmountMul(t *testing.T) {
+	a, _ := ParseAmount("10", 6, "USDC")
+	fee, _ := ParseAmount("0.0025", 4, "")
+	result := a.Mul(fee, RoundDown)
+	assert.Equal(t, "0.025 USDC", result.String(), "Did not receive expected result")
+
+	b, _ := ParseAmount("0.000003", 6, "USDC")
+	half, _ := ParseAmount("0.5", 1, "")
+	assert.Equal(t, "0.000001", b.Mul(half, RoundDown).Text(), "Did not receive expected result")
+	assert.Equal(t, "0.000002", b.Mul(half, RoundHalfUp).Text(), "Did not receive expected result")
+	assert.Equal(t, "0.000002", b.Mul(half, RoundHalfEven).Text(), "Did not receive expected result")
+}
+
+func TestAmountDiv(t *testing.T) {
+	a, _ := ParseAmount("10", 6, "USDC")
+	three, _ := ParseAmount("3", 0, "")
+	result, err := a.Div(three, RoundDown)
+	assert.Nil(t, err, "Failed to divide amount")
+	assert.Equal(t, "3.333333", result.Text(), "Did not receive expected result")
+	result, err = a.Div(three, RoundUp)
+	assert.Nil(t, err, "Failed to divide amount")
+	assert.Equal(t, "3.333334", result.Text(), "Did not receive expected result")
+
+	_, err = a.Div(NewAmount(big.NewInt(0), 0, ""), RoundDown)
+	assert.NotNil(t, err, "Divided by zero")
+}
+
+func TestAmountRounding(t *testing.T) {
+	tests := []struct {
+		value    int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{25, RoundDown, 2},
+		{25, RoundUp, 3},
+		{25, RoundHalfUp, 3},
+		{25, RoundHalfEven, 2},
+		{35, RoundHalfEven, 4},
+		{-25, RoundDown, -2},
+		{-25, RoundUp, -3},
+		{-25, RoundFloor, -3},
+		{-25, RoundCeiling, -2},
+		{-25, RoundHalfUp, -3},
+		{-25, RoundHalfEven, -2},
+		{24, RoundHalfUp, 2},
+		{26, RoundHalfEven, 3},
+	}
+	for _, test := range tests {
+		result := NewAmount(big.NewInt(test.value), 1, "").Rescale(0, test.mode)
+		assert.Equal(t, big.NewInt(test.expected), result.Value, fmt.Sprintf("Did not receive expected result for %d mode %d", test.value, test.mode))
+	}
+}
+
+func TestAmountCmp(t *testing.T) {
+	a, _ := ParseAmount("1.5", 6, "")
+	b, _ := ParseAmount("1.50", 8, "")
+	c, _ := ParseAmount("1.49999999", 8, "")
+	assert.Equal(t, 0, a.Cmp(b), "Did not receive expected result")
+	assert.Equal(t, 1, a.Cmp(c), "Did not receive expected result")
+	assert.Equal(t, -1, c.Cmp(a), "Did not receive expected result")
+}
+
+func TestEtherAmountMatchesWeiToString(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1000000000000000001", 10)
+	assert.Equal(t, "1.000000000000000001 Ether", NewEtherAmount(wei).String(), "Did not receive expected result")
+	assert.Equal(t, WeiToString(wei, true), NewEtherAmount(wei).String(), "Did not receive expected result")
+}
+
+func TestAmountFixedText(t *testing.T) {
+	assert.Equal(t, "1.50", NewAmount(big.NewInt(150), 2, "").FixedText(), "Did not receive expected result")
+	assert.Equal(t, "2.00", NewAmount(big.NewInt(200), 2, "").FixedText(), "Did not receive expected result")
+	assert.Equal(t, "-0.05", NewAmount(big.NewInt(-5), 2, "").FixedText(), "Did not receive expected result")
+	assert.Equal(t, "7", NewAmount(big.NewInt(7), 0, "").FixedText(), "Did not receive expected result")
+}
+
+func TestAppendDecimal(t *testing.T) {
+	values := []string{"0", "1", "-1", "999999999", "1000000000", "18446744073709551615", "18446744073709551616", "10000000000000000000", "-340282366920938463463374607431768211456"}
+	for i := 0; i < 80; i++ {
+		// Powers of ten and their predecessors either side of the fast path
+		value := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(i*5)), nil)
+		values = append(values, value.String(), value.Sub(value, big.NewInt(1)).String())
+	}
+	for _, value := range values {
+		parsed, _ := new(big.Int).SetString(value, 10)
+		assert.Equal(t, value, string(appendDecimal([]byte{}, parsed)), "Did not receive expected result")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ScryptParams are the scrypt parameters used to encrypt keys.  Higher
+// values make brute-forcing passphrases more expensive, at the cost of
+// slower encryption and decryption
+type ScryptParams struct {
+	N int
+	P int
+}
+
+// StandardScrypt is the scrypt strength used by geth, and should be used
+// for real keys
+var StandardScrypt = ScryptParams{N: keystore.StandardScryptN, P: keystore.StandardScryptP}
+
+// LightScrypt is a weaker scrypt strength suitable for tests and
+// low-powered devices
+var LightScrypt = ScryptParams{N: keystore.LightScryptN, P: keystore.LightScryptP}
+
+// KeystoreManager creates and manages accounts in a keystore directory
+type KeystoreManager struct {
+	keystore *keystore.KeyStore
+}
+
+// NewKeystoreManager creates a manager for the keystore in a directory.
+// The directory is created if it does not exist
+func NewKeystoreManager(keydir string, scrypt ScryptParams) *KeystoreManager {
+	return &KeystoreManager{
+		keystore: keystore.NewKeyStore(keydir, scrypt.N, scrypt.P),
+	}
+}
+
+// NewGethKeystoreManager creates a manager for the geth keystore of a chain,
+// which is where ObtainWallet looks first
+func NewGethKeystoreManager(chainID *big.Int, scrypt ScryptParams) *KeystoreManager {
+	return NewKeystoreManager(gethKeystoreDir(chainID), scrypt)
+}
+
+// KeyStore returns the underlying keystore
+func (m *KeystoreManager) KeyStore() *keystore.KeyStore {
+	return m.keystore
+}
+
+// Accounts returns the accounts in the keystore
+func (m *KeystoreManager) Accounts() []accounts.Account {
+	return m.keystore.Accounts()
+}
+
+// Create creates a new account with a random key
+func (m *KeystoreManager) Create(passphrase string) (accounts.Account, error) {
+	return m.keystore.NewAccount(passphrase)
+}
+
+// ImportKey imports a private key
+func (m *KeystoreManager) ImportKey(key *ecdsa.PrivateKey, passphrase string) (accounts.Account, error) {
+	return m.keystore.ImportECDSA(key, passphrase)
+}
+
+// ImportHexKey imports a private key given in hex, with or without a leading
+// 0x
+func (m *KeystoreManager) ImportHexKey(hexKey string, passphrase string) (accounts.Account, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("Invalid private key: %v", err)
+	}
+	return m.ImportKey(key, passphrase)
+}
+
+// ImportKeyFile imports an encrypted key file, such as one exported from
+// another keystore, re-encrypting it with a new passphrase
+func (m *KeystoreManager) ImportKeyFile(path string, passphrase string, newPassphrase string) (accounts.Account, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	return m.keystore.Import(keyJSON, passphrase, newPassphrase)
+}
+
+// Export returns the encrypted key file for an account, re-encrypted with a
+// new passphrase
+func (m *KeystoreManager) Export(address common.Address, passphrase string, newPassphrase string) ([]byte, error) {
+	account, err := m.find(address)
+	if err != nil {
+		return nil, err
+	}
+	return m.keystore.Export(account, passphrase, newPassphrase)
+}
+
+// ExportKeyFile writes the encrypted key file for an account to a path,
+// re-encrypted with a new passphrase
+func (m *KeystoreManager) ExportKeyFile(address common.Address, passphrase string, newPassphrase string, path string) error {
+	keyJSON, err := m.Export(address, passphrase, newPassphrase)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, keyJSON, 0600)
+}
+
+// ChangePassphrase changes the passphrase of an account
+func (m *KeystoreManager) ChangePassphrase(address common.Address, passphrase string, newPassphrase string) error {
+	account, err := m.find(address)
+	if err != nil {
+		return err
+	}
+	return m.keystore.Update(account, passphrase, newPassphrase)
+}
+
+// Delete removes an account from the keystore.  The passphrase is required
+// to avoid accidental deletion
+func (m *KeystoreManager) Delete(address common.Address, passphrase string) error {
+	account, err := m.find(address)
+	if err != nil {
+		return err
+	}
+	return m.keystore.Delete(account, passphrase)
+}
+
+func (m *KeystoreManager) find(address common.Address) (accounts.Account, error) {
+	account, err := m.keystore.Find(accounts.Account{Address: address})
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("Failed to find account %s", address.Hex())
+	}
+	return account, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const testHexKey = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+
+func TestKeystoreManagerCreate(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, err := manager.Create("secret")
+	assert.Nil(t, err, "Failed to create account")
+	assert.Equal(t, 1, len(manager.Accounts()), "Did not receive expected number of accounts")
+	assert.Equal(t, account.Address, manager.Accounts()[0].Address, "Did not receive expected account")
+
+	wallet := manager.KeyStore().Wallets()[0]
+	assert.True(t, VerifyPassphrase(wallet, account, "secret"), "Failed to verify passphrase")
+	assert.False(t, VerifyPassphrase(wallet, account, "wrong"), "Verified wrong passphrase")
+}
+
+func TestKeystoreManagerImportHexKey(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, err := manager.ImportHexKey("0x"+testHexKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	_, err = manager.ImportHexKey(testHexKey, "secret")
+	assert.NotNil(t, err, "Imported duplicate key")
+	_, err = manager.ImportHexKey("0x1234", "secret")
+	assert.NotNil(t, err, "Imported invalid key")
+
+	wallet := manager.KeyStore().Wallets()[0]
+	obtained, err := ObtainAccount(&wallet, &account.Address, "secret")
+	assert.Nil(t, err, "Failed to obtain account")
+	assert.Equal(t, account.Address, obtained.Address, "Did not receive expected account")
+}
+
+func TestKeystoreManagerExportImport(t *testing.T) {
+	source := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, err := source.ImportHexKey(testHexKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+
+	path := filepath.Join(t.TempDir(), "key.json")
+	err = source.ExportKeyFile(account.Address, "wrong", "exported", path)
+	assert.NotNil(t, err, "Exported key with wrong passphrase")
+	err = source.ExportKeyFile(account.Address, "secret", "exported", path)
+	assert.Nil(t, err, "Failed to export key")
+
+	destination := NewKeystoreManager(t.TempDir(), LightScrypt)
+	_, err = destination.ImportKeyFile(path, "secret", "imported")
+	assert.NotNil(t, err, "Imported key with wrong passphrase")
+	imported, err := destination.ImportKeyFile(path, "exported", "imported")
+	assert.Nil(t, err, "Failed to import key file")
+	assert.Equal(t, account.Address, imported.Address, "Did not receive expected account")
+
+	wallet := destination.KeyStore().Wallets()[0]
+	assert.True(t, VerifyPassphrase(wallet, imported, "imported"), "Failed to verify passphrase")
+}
+
+func TestKeystoreManagerExportScrypt(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, _ := manager.ImportHexKey(testHexKey, "secret")
+	keyJSON, err := manager.Export(account.Address, "secret", "exported")
+	assert.Nil(t, err, "Failed to export key")
+	key, err := keystore.DecryptKey(keyJSON, "exported")
+	assert.Nil(t, err, "Failed to decrypt exported key")
+	assert.Equal(t, account.Address, key.Address, "Did not receive expected address")
+}
+
+func TestKeystoreManagerChangePassphrase(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, _ := manager.ImportHexKey(testHexKey, "secret")
+
+	err := manager.ChangePassphrase(account.Address, "wrong", "new")
+	assert.NotNil(t, err, "Changed passphrase with wrong passphrase")
+	err = manager.ChangePassphrase(account.Address, "secret", "new")
+	assert.Nil(t, err, "Failed to change passphrase")
+
+	wallet := manager.KeyStore().Wallets()[0]
+	assert.True(t, VerifyPassphrase(wallet, account, "new"), "Failed to verify new passphrase")
+	assert.False(t, VerifyPassphrase(wallet, account, "secret"), "Verified old passphrase")
+}
+
+func TestKeystoreManagerDelete(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	account, _ := manager.ImportHexKey(testHexKey, "secret")
+	assert.NotNil(t, manager.Delete(account.Address, "wrong"), "Deleted account with wrong passphrase")
+	assert.Nil(t, manager.Delete(account.Address, "secret"), "Failed to delete account")
+	assert.Equal(t, 0, len(manager.Accounts()), "Account still present")
+}
+
+func TestKeystoreManagerUnknownAccount(t *testing.T) {
+	manager := NewKeystoreManager(t.TempDir(), LightScrypt)
+	unknown := common.HexToAddress("0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1")
+	_, err := manager.Export(unknown, "secret", "secret")
+	assert.NotNil(t, err, "Exported unknown account")
+	assert.NotNil(t, manager.ChangePassphrase(unknown, "secret", "new"), "Changed passphrase of unknown account")
+}
@@ -24,9 +24,15 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
 	homedir "github.com/mitchellh/go-homedir"
+	etherutils "github.com/orinocopay/go-etherutils"
+)
+
+// Chain IDs of test networks that go-ethereum no longer describes
+var (
+	ropstenChainID = big.NewInt(3)
+	rinkebyChainID = big.NewInt(4)
 )
 
 // ObtainWallet fetches the wallet for a given address
@@ -45,27 +51,58 @@ func ObtainWallet(chainID *big.Int, address common.Address) (accounts.Wallet, er
 }
 
 func obtainGethWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
-	keydir := node.DefaultDataDir()
+	backends := []accounts.Backend{keystore.NewKeyStore(gethKeystoreDir(chainID), keystore.StandardScryptN, keystore.StandardScryptP)}
+	accountManager := accounts.NewManager(&accounts.Config{}, backends...)
+	defer accountManager.Close()
+	account := accounts.Account{Address: address}
+	wallet, err := accountManager.Find(account)
+	return wallet, err
+}
+
+// gethDataDir returns geth's default data directory
+func gethDataDir() string {
+	home, err := homedir.Dir()
+	if err != nil {
+		return ""
+	}
+	if runtime.GOOS == "windows" {
+		return filepath.Join(home, "AppData", "Roaming", "Ethereum")
+	} else if runtime.GOOS == "darwin" {
+		return filepath.Join(home, "Library", "Ethereum")
+	}
+	return filepath.Join(home, ".ethereum")
+}
+
+func gethKeystoreDir(chainID *big.Int) string {
+	keydir := gethDataDir()
 	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
 		// Nothing to add for mainnet
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(ropstenChainID) == 0 {
 		keydir = filepath.Join(keydir, "testnet")
-	} else if chainID.Cmp(params.RinkebyChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(rinkebyChainID) == 0 {
 		keydir = filepath.Join(keydir, "rinkeby")
 	}
-	keydir = filepath.Join(keydir, "keystore")
+	return filepath.Join(keydir, "keystore")
+}
+
+func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
+	keydir, err := parityKeystoreDir(chainID)
+	if err != nil {
+		return nil, err
+	}
+
 	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
-	accountManager := accounts.NewManager(backends...)
+	accountManager := accounts.NewManager(&accounts.Config{}, backends...)
 	defer accountManager.Close()
 	account := accounts.Account{Address: address}
 	wallet, err := accountManager.Find(account)
 	return wallet, err
 }
 
-func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
+func parityKeystoreDir(chainID *big.Int) (string, error) {
 	keydir, err := homedir.Dir()
 	if err != nil {
-		return nil, fmt.Errorf("Failed to find home directory")
+		return "", fmt.Errorf("Failed to find home directory")
 	}
 	if runtime.GOOS == "windows" {
 		keydir = filepath.Join(keydir, "AppData\\Roaming\\Parity\\Ethereum\\keys")
@@ -74,21 +111,43 @@ func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wall
 	} else if runtime.GOOS == "linux" {
 		keydir = filepath.Join(keydir, ".local/share/io.parity.ethereum/keys")
 	} else {
-		return nil, fmt.Errorf("Unsupported operating system")
+		return "", fmt.Errorf("Unsupported operating system")
 	}
 
 	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
 		keydir = filepath.Join(keydir, "ethereum")
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(ropstenChainID) == 0 {
 		keydir = filepath.Join(keydir, "test")
 	}
+	return keydir, nil
+}
 
-	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
-	accountManager := accounts.NewManager(backends...)
-	defer accountManager.Close()
-	account := accounts.Account{Address: address}
-	wallet, err := accountManager.Find(account)
-	return wallet, err
+// KeystoreDirs returns the geth and Parity keystore directories for a chain,
+// in the order that ObtainWallet searches them
+func KeystoreDirs(chainID *big.Int) []string {
+	keydirs := []string{gethKeystoreDir(chainID)}
+	if keydir, err := parityKeystoreDir(chainID); err == nil {
+		keydirs = append(keydirs, keydir)
+	}
+	return keydirs
+}
+
+// PopulateRouterSigner adds signers to a router for the accounts in the geth
+// and Parity keystores that have a passphrase supplied.  It returns an error
+// if any of the accounts cannot be found
+func PopulateRouterSigner(router *etherutils.RouterSigner, chainID *big.Int, passphrases map[common.Address]string) error {
+	for _, keydir := range KeystoreDirs(chainID) {
+		ks := keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)
+		if _, err := router.AddKeystore(ks, passphrases); err != nil {
+			return err
+		}
+	}
+	for address := range passphrases {
+		if !router.Has(address) {
+			return fmt.Errorf("Failed to find account %s", address.Hex())
+		}
+	}
+	return nil
 }
 
 // ObtainAccount fetches the account for a given address
@@ -107,6 +166,6 @@ func ObtainAccount(wallet *accounts.Wallet, address *common.Address, passphrase
 // VerifyPassphrase confirms that a passphrase is correct for an account
 func VerifyPassphrase(wallet accounts.Wallet, account accounts.Account, passphrase string) bool {
 
-	_, err := wallet.SignHashWithPassphrase(account, passphrase, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	_, err := wallet.SignDataWithPassphrase(account, passphrase, accounts.MimetypeTextPlain, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 	return err == nil
 }
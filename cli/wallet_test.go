@@ -0,0 +1,83 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"math/big"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	homedir "github.com/mitchellh/go-homedir"
+	etherutils "github.com/orinocopay/go-etherutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// testHome points the home directory at a temporary directory for the test
+func testHome(t *testing.T) string {
+	if runtime.GOOS != "linux" {
+		t.Skip("Keystore layout test only runs on linux")
+	}
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false })
+	return home
+}
+
+// testKeystoreAccount creates an account in a keystore directory
+func testKeystoreAccount(t *testing.T, keydir string, passphrase string) accounts.Account {
+	account, err := keystore.NewKeyStore(keydir, keystore.LightScryptN, keystore.LightScryptP).NewAccount(passphrase)
+	assert.Nil(t, err, "Failed to create account")
+	return account
+}
+
+func TestKeystoreDirs(t *testing.T) {
+	home := testHome(t)
+	mainnet := big.NewInt(1)
+	assert.Equal(t, []string{
+		filepath.Join(home, ".ethereum", "keystore"),
+		filepath.Join(home, ".local", "share", "io.parity.ethereum", "keys", "ethereum"),
+	}, KeystoreDirs(mainnet), "Did not receive expected directories")
+
+	assert.Equal(t, filepath.Join(home, ".ethereum", "rinkeby", "keystore"), KeystoreDirs(big.NewInt(4))[0], "Did not receive expected directory")
+}
+
+func TestPopulateRouterSigner(t *testing.T) {
+	home := testHome(t)
+	mainnet := big.NewInt(1)
+	gethAccount := testKeystoreAccount(t, filepath.Join(home, ".ethereum", "keystore"), "geth secret")
+	parityAccount := testKeystoreAccount(t, filepath.Join(home, ".local", "share", "io.parity.ethereum", "keys", "ethereum"), "parity secret")
+	// Accounts without a passphrase are not added
+	otherAccount := testKeystoreAccount(t, filepath.Join(home, ".ethereum", "keystore"), "other secret")
+
+	router, _ := etherutils.NewRouterSigner()
+	err := PopulateRouterSigner(router, mainnet, map[common.Address]string{
+		gethAccount.Address:   "geth secret",
+		parityAccount.Address: "parity secret",
+	})
+	assert.Nil(t, err, "Failed to populate router")
+	assert.True(t, router.Has(gethAccount.Address), "Did not add geth account")
+	assert.True(t, router.Has(parityAccount.Address), "Did not add Parity account")
+	assert.False(t, router.Has(otherAccount.Address), "Added account without passphrase")
+
+	// Unknown account
+	router, _ = etherutils.NewRouterSigner()
+	err = PopulateRouterSigner(router, mainnet, map[common.Address]string{common.HexToAddress("0x01"): "secret"})
+	assert.NotNil(t, err, "Did not receive error")
+}
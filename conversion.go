@@ -19,7 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -27,186 +27,266 @@ import (
 // The string can be a simple number of Wei, e.g. "1000000000000000" or it can
 // be a number followed by a unit, e.g. "10 ether".  Unit names are
 // case-insensitive, and can be either given names (e.g. "finney") or metric
-// names (e.g. "mlliether").
-// Note that this function expects use of the period as the decimal separator.
+// names (e.g. "mlliether"), or any unit added with RegisterUnit().
+// The number can use exponent notation, e.g. "1.5e18 wei" or "2e-3 ether",
+// and a hex quantity such as "0xde0b6b3a7640000" is taken as a number of Wei.
+// Any value that results in a fractional number of Wei is rejected.
+// Note that this function expects use of the period as the decimal separator;
+// use Locale.StringToWei for input written with other separators.
 func StringToWei(input string) (*big.Int, error) {
+	var result big.Int
+	if err := StringToWeiInto(input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// StringToWeiInto is StringToWei storing the value in result rather than a
+// new big.Int.  Reusing result when parsing many amounts avoids allocations
+// for values below 2^128 Wei written as a number, an optional exponent that
+// does not move the decimal point below Wei, and a unit separated by at most
+// one run of spaces.  result is undefined if an error is returned
+func StringToWeiInto(input string, result *big.Int) error {
 	if input == "" {
-		return nil, errors.New("Failed to parse empty value")
+		return errors.New("Failed to parse empty value")
 	}
-	input = strings.Replace(input, " ", "", -1)
-	var result big.Int
-	// Separate the number from the unit (if any)
-	re := regexp.MustCompile("([0-9]*(?:\\.[0-9]*)?)([A-Za-z]+)?")
-	s := re.FindAllStringSubmatch(input, -1)
-	units := "Wei"
-	if len(s) != 1 {
-		return nil, errors.New("Invalid format")
-	}
-	if len(s[0]) < 2 || len(s[0]) > 3 {
-		return nil, errors.New("Invalid format")
-	}
-	if len(s[0]) == 2 {
-		// No format; simple number of Wei
-		result.SetString(input, 10)
+	var number, unit string
+	var exponent int
+	var err error
+	if pos := strings.IndexByte(input, ' '); pos != -1 {
+		number, exponent, unit, err = splitSpacedAmount(input, pos)
+	} else if len(input) > 1 && input[0] == '0' && (input[1] == 'x' || input[1] == 'X') {
+		return setHexWei(input, result)
 	} else {
-		units = s[0][2]
-		if strings.Contains(s[0][1], ".") {
-			err := decimalStringToWei(s[0][1], units, &result)
-			if err != nil {
-				return nil, err
+		// Separate the number from the exponent and unit (if any)
+		number, exponent, unit, err = splitAmount(input)
+	}
+	if err == errHexAmount {
+		return setHexWei(strings.Replace(input, " ", "", -1), result)
+	}
+	if err != nil {
+		return err
+	}
+	if err = scaledStringToWei(number, exponent, unit, result); err != nil {
+		return err
+	}
+
+	// Ensure we don't have a negative number
+	if result.Sign() < 0 {
+		return errors.New("Value resulted in negative number of Wei")
+	}
+	return nil
+}
+
+// errHexAmount reports that a spaced amount is a hex quantity
+var errHexAmount = errors.New("hex amount")
+
+// setHexWei sets result to a hex quantity such as "0xde0b6b3a7640000"
+func setHexWei(input string, result *big.Int) error {
+	if len(input) < 2 || input[0] != '0' || (input[1] != 'x' && input[1] != 'X') || !isHex(input[2:]) {
+		return fmt.Errorf("Invalid hex value %s", input)
+	}
+	result.SetString(input[2:], 16)
+	return nil
+}
+
+// splitSpacedAmount splits an amount containing spaces, the first at pos,
+// as splitAmount does once the spaces are removed.  The usual form of a
+// number and a unit separated by spaces is split without copying the input
+func splitSpacedAmount(input string, pos int) (number string, exponent int, unit string, err error) {
+	head := strings.TrimLeft(input[:pos], " ")
+	tail := strings.Trim(input[pos:], " ")
+	if head != "" && strings.IndexByte(head, ' ') == -1 && strings.IndexByte(tail, ' ') == -1 {
+		number, exponent, unit, err = splitAmount(head)
+		if err == nil && unit == "" {
+			// The tail must be a unit on its own, so it cannot be read
+			// as an exponent once joined to the number
+			tailNumber, tailExponent, tailUnit, tailErr := splitAmount(tail)
+			if tailErr == nil && tailNumber == "" && tailExponent == 0 && tailUnit == tail && tail != "" {
+				return number, exponent, tail, nil
 			}
-		} else {
-			err := integerStringToWei(s[0][1], units, &result)
-			if err != nil {
-				return nil, err
+		}
+	}
+
+	// Anything else is split with the spaces removed
+	input = strings.Replace(input, " ", "", -1)
+	if len(input) > 1 && input[0] == '0' && (input[1] == 'x' || input[1] == 'X') {
+		return "", 0, "", errHexAmount
+	}
+	return splitAmount(input)
+}
+
+// maxExponent is the largest exponent accepted by StringToWei
+const maxExponent = 1000
+
+// splitAmount splits an amount without spaces in to its number, exponent and
+// unit.  The number is digits with an optional decimal point, the exponent
+// is 'e' or 'E' followed by an optionally signed integer, and the unit
+// starts with a letter followed by letters, digits, '-' or '_'
+func splitAmount(input string) (number string, exponent int, unit string, err error) {
+	i := 0
+	for i < len(input) && isDigit(input[i]) {
+		i++
+	}
+	if i < len(input) && input[i] == '.' {
+		i++
+		for i < len(input) && isDigit(input[i]) {
+			i++
+		}
+	}
+	number = input[:i]
+
+	if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+		start := i + 1
+		if start < len(input) && (input[start] == '+' || input[start] == '-') {
+			start++
+		}
+		end := start
+		for end < len(input) && isDigit(input[end]) {
+			end++
+		}
+		// Without digits this is the start of the unit rather than an exponent
+		if end > start {
+			exponent, err = strconv.Atoi(input[i+1 : end])
+			if err != nil || exponent > maxExponent || exponent < -maxExponent {
+				return "", 0, "", fmt.Errorf("Exponent out of range in %s", input)
 			}
+			i = end
 		}
 	}
 
-	// Ensure we don't have a negative number
-	if result.Cmp(new(big.Int)) < 0 {
-		return nil, errors.New("Value resulted in negative number of Wei")
+	if i < len(input) {
+		if !isUnitLetter(rune(input[i])) {
+			return "", 0, "", errors.New("Invalid format")
+		}
+		for j := i + 1; j < len(input); j++ {
+			c := input[j]
+			if !isUnitLetter(rune(c)) && !isDigit(c) && c != '-' && c != '_' {
+				return "", 0, "", errors.New("Invalid format")
+			}
+		}
+		unit = input[i:]
 	}
+	return number, exponent, unit, nil
+}
 
-	// return nil, errors.New("Failed to parse value")
-	return &result, nil
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
 }
 
-// Used in WeiToString
-var zero = big.NewInt(0)
-var thousand = big.NewInt(1000)
-var million = big.NewInt(1000000)
+// isHex returns true if the input is a non-empty string of hex digits
+func isHex(input string) bool {
+	if input == "" {
+		return false
+	}
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if !isDigit(c) && !(c >= 'a' && c <= 'f') && !(c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
 
 // WeiToString turns a number of Wei in to a string.
 // If the 'standard' argument is true then this will display the value
 // in either (KMG)Wei or Ether only
 func WeiToString(input *big.Int, standard bool) string {
-	// Take a copy of the input so that we can mutate it
-	value := new(big.Int).Set(input)
+	return string(AppendWei(nil, input, standard))
+}
 
+// AppendWei appends the string form of a number of Wei, as generated by
+// WeiToString, to dst and returns the extended buffer.  It does not allocate
+// if dst has room for the result, unless the value is beyond 2^512 Wei
+func AppendWei(dst []byte, input *big.Int, standard bool) []byte {
 	// Input sanity checks
-	if value.Cmp(zero) == 0 {
-		return "0"
+	if input.Sign() == 0 {
+		return append(dst, '0')
 	}
 
+	start := len(dst)
+	dst = appendDecimal(dst, input)
+	unit := weiUnitFromDigits(dst[start:], standard)
+	if unit >= len(metricUnits) {
+		// Larger than any unit; show in the largest
+		unit = len(metricUnits) - 1
+	}
+	dst = placeDecimal(dst, start, unit*3)
+	dst = append(dst, ' ')
+	return append(dst, metricUnits[unit]...)
+}
+
+// weiUnit picks the index of the metric unit in which to display a number
+// of Wei
+func weiUnit(input *big.Int, standard bool) int {
+	var buf [80]byte
+	return weiUnitFromDigits(appendDecimal(buf[:0], input), standard)
+}
+
+// weiUnitFromDigits picks the index of the metric unit in which to display
+// a number of Wei given its decimal digits
+func weiUnitFromDigits(digits []byte, standard bool) int {
 	postfixPos := 0
-	modInt := new(big.Int).Set(value)
 	// Step 1: step down whole thousands for our first attempt at the unit
-	for value.Cmp(thousand) >= 0 && modInt.Mod(value, thousand).Cmp(zero) == 0 {
-		postfixPos++
-		value = value.Div(value, thousand)
-		modInt = modInt.Set(value)
+	if digits[0] != '-' {
+		zeros := 0
+		for zeros < len(digits) && digits[len(digits)-1-zeros] == '0' {
+			zeros++
+		}
+		postfixPos = zeros / 3
 	}
 
 	// Step 2: move to a fraction if sensible
-
-	// Because of the innacuracy of floating point we use string manipulation
-	// to place the decimal in the correct position
-	outputValue := value.Text(10)
-
+	length := len(digits) - postfixPos*3
 	desiredPostfixPos := postfixPos
-	if len(outputValue) > 3 {
-		desiredPostfixPos += len(outputValue) / 3
-		if len(outputValue)%3 == 0 {
+	if length > 3 {
+		desiredPostfixPos += length / 3
+		if length%3 == 0 {
 			desiredPostfixPos--
 		}
 	}
-	decimalPlace := len(outputValue)
 	if desiredPostfixPos > 3 && standard {
 		// We want this in a standard unit.  We will show up to
 		// 999999999999 in (KMG)Wei and anything higher in Ether
 		desiredPostfixPos = 6
 	}
-	for postfixPos < desiredPostfixPos {
-		decimalPlace -= 3
-		postfixPos++
-	}
-	for postfixPos > desiredPostfixPos {
-		outputValue = outputValue + strings.Repeat("0", 3)
-		decimalPlace += 3
-		postfixPos--
-	}
-	if decimalPlace <= 0 {
-		outputValue = "0." + strings.Repeat("0", 0-decimalPlace) + outputValue
-	} else if decimalPlace < len(outputValue) {
-		outputValue = outputValue[:decimalPlace] + "." + outputValue[decimalPlace:]
-	}
-
-	// Trim trailing zeros if this is a decimal
-	if strings.Contains(outputValue, ".") {
-		outputValue = strings.TrimRight(outputValue, "0")
-	}
-
-	// Return our value
-	return fmt.Sprintf("%s %s", outputValue, metricUnits[postfixPos])
+	return desiredPostfixPos
 }
 
-func decimalStringToWei(amount string, unit string, result *big.Int) error {
-	// Because floating point maths is not accurate we need to break potentially
-	// large decimal fractions in to two separate pieces: the integer part and the
-	// decimal part
-	parts := strings.Split(amount, ".")
-
-	// The value for the integer part of the number is easy
-	if parts[0] != "" {
-		err := integerStringToWei(parts[0], unit, result)
-		if err != nil {
-			return fmt.Errorf("Failed to parse %s %s", amount, unit)
-		}
-	}
-
-	// The value for the decimal part of the number is harder.  We left-shift it
-	// so that we end up multiplying two integers rather than two floats, as the
-	// latter is unreliable
-
-	// Obtain multiplier
-	// This will never fail because it is already called above in integerStringToWei()
-	multiplier, _ := UnitToMultiplier(unit)
+// unitStringToWei turns a plain decimal number in a given unit in to Wei
+func unitStringToWei(amount string, unit string, result *big.Int) error {
+	return scaledStringToWei(amount, 0, unit, result)
+}
 
-	// Trim trailing 0s
-	trimmedDecimal := strings.TrimRight(parts[1], "0")
-	if len(trimmedDecimal) == 0 {
-		// Nothing more to do
-		return nil
+// scaledStringToWei turns a plain decimal number multiplied by 10^exponent
+// in a given unit in to Wei.  It parses with setFixed, as Amount does
+func scaledStringToWei(amount string, exponent int, unit string, result *big.Int) error {
+	// Obtain unit
+	u, err := DefaultUnits.Lookup(unit)
+	if err != nil {
+		return fmt.Errorf("Failed to parse unit of %s %s", amount, unit)
 	}
-	var decVal big.Int
-	decVal.SetString(trimmedDecimal, 10)
 
-	// Divide multiplier by 10^len(trimmed decimal) to obtain sane value
-	div := big.NewInt(10)
-	for i := 0; i < len(trimmedDecimal); i++ {
-		multiplier.Div(multiplier, div)
+	decimals := u.Decimals + exponent
+	places := decimals
+	if places < 0 {
+		places = 0
 	}
-
-	// Ensure we don't have a fractional number of Wei
-	if multiplier.Sign() == 0 {
+	err = setFixed(result, amount, places)
+	if err == errExcessPrecision {
 		return errors.New("Value resulted in fractional number of Wei")
 	}
-
-	var decResult big.Int
-	decResult.Mul(multiplier, &decVal)
-
-	// Add it to the integer result
-	result.Add(result, &decResult)
-
-	return nil
-}
-
-func integerStringToWei(amount string, unit string, result *big.Int) error {
-	// Obtain number
-	number := new(big.Int)
-	_, success := number.SetString(amount, 10)
-	if !success {
+	if err != nil {
 		return fmt.Errorf("Failed to parse numeric value of %s %s", amount, unit)
 	}
-
-	// Obtain multiplier
-	multiplier, err := UnitToMultiplier(unit)
-	if err != nil {
-		return fmt.Errorf("Failed to parse unit of %s %s", amount, unit)
+	if decimals < 0 {
+		// Negative exponent larger than the unit; scale down exactly
+		divisor := pow10(-decimals)
+		if new(big.Int).Mod(result, divisor).Sign() != 0 {
+			return errors.New("Value resulted in fractional number of Wei")
+		}
+		result.Div(result, divisor)
 	}
-
-	result.Mul(number, multiplier)
 	return nil
 }
 
@@ -214,37 +294,14 @@ func integerStringToWei(amount string, unit string, result *big.Int) error {
 var metricUnits = [...]string{"Wei", "KWei", "MWei", "GWei", "Microether", "Milliether", "Ether", "Kiloether", "Megaether", "Gigaether", "Teraether"}
 
 // Named units
-// var namedUnits = [...]string{"Wei", "Ada", "Babbage", "Shannon", "Szazbo", "Finney", "Ether", "Einstein", "Kilo", "Mega", "Giga", "Tera"}
+var namedUnits = [...]string{"Wei", "Ada", "Babbage", "Shannon", "Szabo", "Finney", "Ether", "Einstein", "Mega", "Giga", "Tera"}
 
 // UnitToMultiplier takes the name of an Ethereum unit and returns a multiplier
-//  from Wei
+// from Wei.  Units are looked up in DefaultUnits
 func UnitToMultiplier(unit string) (result *big.Int, err error) {
-	result = big.NewInt(0)
-	switch strings.ToLower(unit) {
-	case "", "wei":
-		result.SetString("1", 10)
-	case "ada", "kwei", "kilowei":
-		result.SetString("1000", 10)
-	case "babbage", "mwei", "megawei":
-		result.SetString("1000000", 10)
-	case "shannon", "gwei", "gigawei":
-		result.SetString("1000000000", 10)
-	case "szazbo", "micro", "microether":
-		result.SetString("1000000000000", 10)
-	case "finney", "milli", "milliether":
-		result.SetString("1000000000000000", 10)
-	case "ether":
-		result.SetString("1000000000000000000", 10)
-	case "einstein", "kilo", "kiloether":
-		result.SetString("1000000000000000000000", 10)
-	case "mega", "megaether":
-		result.SetString("1000000000000000000000000", 10)
-	case "giga", "gigaether":
-		result.SetString("1000000000000000000000000000", 10)
-	case "tera", "teraether":
-		result.SetString("1000000000000000000000000000000", 10)
-	default:
-		err = fmt.Errorf("Unknown unit %s", unit)
-	}
-	return
+	u, err := DefaultUnits.Lookup(unit)
+	if err != nil {
+		return nil, err
+	}
+	return u.Multiplier(), nil
 }
@@ -701,3 +701,161 @@ func ExampleUnitToMultiplier() {
 	fmt.Println(multiplier.Text(10))
 	// Output: 1000000000000000000
 }
+
+func TestStringToWeiWithHexValue(t *testing.T) {
+	expected, _ := new(big.Int).SetString("1000000000000000000", 10)
+	result, err := StringToWei("0xde0b6b3a7640000")
+	assert.Nil(t, err, "Failed to convert hex string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToWeiWithBadHexValue(t *testing.T) {
+	_, err := StringToWei("0xdefg")
+	assert.NotNil(t, err, "Converted string with bad hex to Wei value")
+	_, err = StringToWei("0x")
+	assert.NotNil(t, err, "Converted string with empty hex to Wei value")
+	_, err = StringToWei("0x-1")
+	assert.NotNil(t, err, "Converted string with negative hex to Wei value")
+}
+
+func TestStringToWeiWithExponent(t *testing.T) {
+	expected, _ := new(big.Int).SetString("1500000000000000000", 10)
+	result, err := StringToWei("1.5e18 wei")
+	assert.Nil(t, err, "Failed to convert exponent string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+	result, err = StringToWei("1.5E18")
+	assert.Nil(t, err, "Failed to convert exponent string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToWeiWithNegativeExponent(t *testing.T) {
+	expected, _ := new(big.Int).SetString("2000000000000000", 10)
+	result, err := StringToWei("2e-3 ether")
+	assert.Nil(t, err, "Failed to convert exponent string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+	result, err = StringToWei("2000e-3 wei")
+	assert.Nil(t, err, "Failed to convert exponent string to Wei")
+	assert.Equal(t, big.NewInt(2), result, "Did not receive expected result")
+}
+
+func TestStringToWeiWithFractionalExponent(t *testing.T) {
+	_, err := StringToWei("1.5e-1 wei")
+	assert.NotNil(t, err, "Converted string with fractional Wei to Wei value")
+	_, err = StringToWei("15e-19 ether")
+	assert.NotNil(t, err, "Converted string with fractional Wei to Wei value")
+}
+
+func TestStringToWeiWithLargeExponent(t *testing.T) {
+	_, err := StringToWei("1e100000000 wei")
+	assert.NotNil(t, err, "Converted string with huge exponent to Wei value")
+}
+
+var benchmarkInputs = []string{"1000000000000000000", "0.024ether", "85748574 microether", "1.5 GWei", "2e-3 ether"}
+
+func BenchmarkStringToWei(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := StringToWei(benchmarkInputs[i%len(benchmarkInputs)])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWeiToString(b *testing.B) {
+	wei, _ := new(big.Int).SetString("1234567890000000000", 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WeiToString(wei, true)
+	}
+}
+
+func TestWeiToStringWithoutStandardBeyondUnits(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1000000000000000000000000000000000", 10)
+	assert.Equal(t, "1000 Teraether", WeiToString(wei, false), "Did not receive expected result")
+	wei, _ = new(big.Int).SetString("-1234567000000000000000000000000000000", 10)
+	assert.Equal(t, "-1234567 Teraether", WeiToString(wei, false), "Did not receive expected result")
+}
+
+func TestAppendWei(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1234567890000000000", 10)
+	buf := []byte("cost: ")
+	buf = AppendWei(buf, wei, true)
+	assert.Equal(t, "cost: 1.23456789 Ether", string(buf), "Did not receive expected result")
+	buf = AppendWei(buf[:0], big.NewInt(0), true)
+	assert.Equal(t, "0", string(buf), "Did not receive expected result")
+	buf = AppendWei(buf[:0], big.NewInt(-12345), false)
+	assert.Equal(t, "-12.345 KWei", string(buf), "Did not receive expected result")
+	buf = AppendWei(buf[:0], big.NewInt(5), false)
+	assert.Equal(t, "5 Wei", string(buf), "Did not receive expected result")
+	buf = AppendWei(buf[:0], big.NewInt(1000000000000005), true)
+	assert.Equal(t, "0.001000000000000005 Ether", string(buf), "Did not receive expected result")
+}
+
+func BenchmarkAppendWei(b *testing.B) {
+	wei, _ := new(big.Int).SetString("1234567890000000000", 10)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendWei(buf[:0], wei, true)
+	}
+}
+
+func TestStringToWeiInto(t *testing.T) {
+	var result big.Int
+	inputs := map[string]string{
+		"1.5 GWei":              "1500000000",
+		"  2   ether ":          "2000000000000000000",
+		"2 mega wei":            "2000000",
+		"1e3 wei":               "1000",
+		"1 e3 wei":              "1000",
+		"0x 10":                 "16",
+		"1 e-x":                 "",
+		"340282366920938463463": "340282366920938463463",
+		"340282366920938463463.374607431768211455 ether": "340282366920938463463374607431768211455",
+		"340282366920938463463.374607431768211456 ether": "340282366920938463463374607431768211456",
+	}
+	for input, expected := range inputs {
+		err := StringToWeiInto(input, &result)
+		if expected == "" {
+			assert.NotNil(t, err, "Converted invalid string %s to Wei value", input)
+			continue
+		}
+		assert.Nil(t, err, "Failed to convert %s to Wei", input)
+		assert.Equal(t, expected, result.String(), "Did not receive expected result for %s", input)
+	}
+}
+
+func TestStringToWeiIntoAllocs(t *testing.T) {
+	var result big.Int
+	// Allocate result's storage up front
+	_ = StringToWeiInto("1000000000000000000000 ether", &result)
+	for _, input := range benchmarkInputs {
+		allocs := testing.AllocsPerRun(100, func() {
+			if err := StringToWeiInto(input, &result); err != nil {
+				t.Fatal(err)
+			}
+		})
+		assert.Equal(t, float64(0), allocs, "Did not receive expected allocations for %s", input)
+	}
+}
+
+func TestAppendWeiAllocs(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1234567890000000000", 10)
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendWei(buf[:0], wei, true)
+	})
+	assert.Equal(t, float64(0), allocs, "Did not receive expected allocations")
+}
+
+func BenchmarkStringToWeiInto(b *testing.B) {
+	var result big.Int
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		err := StringToWeiInto(benchmarkInputs[i%len(benchmarkInputs)], &result)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TypedDataDomainSeparator returns the EIP-712 hash of the typed data's domain
+func TypedDataDomainSeparator(data *apitypes.TypedData) ([]byte, error) {
+	hash, err := data.HashStruct("EIP712Domain", data.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash domain: %v", err)
+	}
+	return hash, nil
+}
+
+// TypedDataMessageHash returns the EIP-712 hash of the typed data's message
+func TypedDataMessageHash(data *apitypes.TypedData) ([]byte, error) {
+	hash, err := data.HashStruct(data.PrimaryType, data.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash message: %v", err)
+	}
+	return hash, nil
+}
+
+// typedDataRaw returns the EIP-712 encoding that is hashed and signed,
+// 0x19 0x01 || domainSeparator || hashStruct(message)
+func typedDataRaw(data *apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := TypedDataDomainSeparator(data)
+	if err != nil {
+		return nil, err
+	}
+	messageHash, err := TypedDataMessageHash(data)
+	if err != nil {
+		return nil, err
+	}
+	raw := make([]byte, 0, 66)
+	raw = append(raw, 0x19, 0x01)
+	raw = append(raw, domainSeparator...)
+	return append(raw, messageHash...), nil
+}
+
+// TypedDataHash returns the EIP-712 hash of typed data, which is the value
+// that is signed
+func TypedDataHash(data *apitypes.TypedData) ([]byte, error) {
+	raw, err := typedDataRaw(data)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(raw), nil
+}
+
+// SignTypedDataWithKey signs EIP-712 typed data using a private key.
+// The signature is 65 bytes [R || S || V] where V is 27 or 28
+func SignTypedDataWithKey(key *ecdsa.PrivateKey, data *apitypes.TypedData) ([]byte, error) {
+	hash, err := TypedDataHash(data)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// SignTypedDataWithAccount signs EIP-712 typed data using an account.
+// The signature is 65 bytes [R || S || V] where V is 27 or 28
+func SignTypedDataWithAccount(wallet *accounts.Wallet, account *accounts.Account, passphrase string, data *apitypes.TypedData) ([]byte, error) {
+	raw, err := typedDataRaw(data)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := (*wallet).SignDataWithPassphrase(*account, passphrase, accounts.MimetypeTypedData, raw)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// RecoverTypedData recovers the address that signed EIP-712 typed data.
+// The V value of the signature can be 27 or 28, or 0 or 1
+func RecoverTypedData(data *apitypes.TypedData, signature []byte) (common.Address, error) {
+	hash, err := TypedDataHash(data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverAddress(hash, signature)
+}
+
+// VerifyTypedData checks that EIP-712 typed data was signed by an address
+func VerifyTypedData(data *apitypes.TypedData, signature []byte, address common.Address) (bool, error) {
+	signer, err := RecoverTypedData(data, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
+
+// recoverAddress recovers the address that signed a hash.  The V value of
+// the signature can be 27 or 28, or 0 or 1
+func recoverAddress(hash []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, errors.New("invalid signature recovery ID")
+	}
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// Reference vectors from the example in EIP-712
+var mailKey, _ = crypto.ToECDSA(crypto.Keccak256([]byte("cow")))
+var mailAddress = common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")
+var mailSignature = hexutil.MustDecode("0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c")
+
+func mailTypedData() *apitypes.TypedData {
+	return &apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(big.NewInt(1)),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
+func TestTypedDataTypeHash(t *testing.T) {
+	data := mailTypedData()
+	assert.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", string(data.EncodeType("Mail")), "Did not receive expected type encoding")
+	assert.Equal(t, "0xa0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2", hexutil.Encode(data.TypeHash("Mail")), "Did not receive expected type hash")
+}
+
+func TestTypedDataDomainSeparator(t *testing.T) {
+	hash, err := TypedDataDomainSeparator(mailTypedData())
+	assert.Nil(t, err, "Failed to hash domain")
+	assert.Equal(t, "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hexutil.Encode(hash), "Did not receive expected domain separator")
+}
+
+func TestTypedDataMessageHash(t *testing.T) {
+	hash, err := TypedDataMessageHash(mailTypedData())
+	assert.Nil(t, err, "Failed to hash message")
+	assert.Equal(t, "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hexutil.Encode(hash), "Did not receive expected message hash")
+}
+
+func TestTypedDataHash(t *testing.T) {
+	hash, err := TypedDataHash(mailTypedData())
+	assert.Nil(t, err, "Failed to hash typed data")
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hexutil.Encode(hash), "Did not receive expected hash")
+}
+
+func TestTypedDataHashUnknownType(t *testing.T) {
+	data := mailTypedData()
+	data.PrimaryType = "Letter"
+	_, err := TypedDataHash(data)
+	assert.NotNil(t, err, "Hashed message of unknown type")
+}
+
+func TestSignTypedDataWithKey(t *testing.T) {
+	assert.Equal(t, mailAddress, crypto.PubkeyToAddress(mailKey.PublicKey), "Did not receive expected address")
+	signature, err := SignTypedDataWithKey(mailKey, mailTypedData())
+	assert.Nil(t, err, "Failed to sign typed data")
+	assert.Equal(t, mailSignature, signature, "Did not receive expected signature")
+}
+
+func TestSignTypedDataWithAccount(t *testing.T) {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(mailKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	wallet := ks.Wallets()[0]
+	signature, err := SignTypedDataWithAccount(&wallet, &account, "secret", mailTypedData())
+	assert.Nil(t, err, "Failed to sign typed data")
+	assert.Equal(t, mailSignature, signature, "Did not receive expected signature")
+
+	_, err = SignTypedDataWithAccount(&wallet, &account, "wrong", mailTypedData())
+	assert.NotNil(t, err, "Signed with bad passphrase")
+}
+
+func TestSignTypedDataSigners(t *testing.T) {
+	for _, signer := range []Signer{NewMemorySigner(mailKey), newMailKeystoreSigner(t)} {
+		signature, err := signer.SignTypedData(mailTypedData())
+		assert.Nil(t, err, "Failed to sign typed data")
+		assert.Equal(t, mailSignature, signature, "Did not receive expected signature")
+	}
+}
+
+func newMailKeystoreSigner(t *testing.T) *KeystoreSigner {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err := ks.ImportECDSA(mailKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	signer, err := NewKeystoreSigner(ks, mailAddress, "secret")
+	assert.Nil(t, err, "Failed to create keystore signer")
+	return signer
+}
+
+func TestRecoverTypedData(t *testing.T) {
+	address, err := RecoverTypedData(mailTypedData(), mailSignature)
+	assert.Nil(t, err, "Failed to recover address")
+	assert.Equal(t, mailAddress, address, "Did not receive expected address")
+}
+
+func TestRecoverTypedDataZeroRecoveryID(t *testing.T) {
+	signature := common.CopyBytes(mailSignature)
+	signature[64] -= 27
+	address, err := RecoverTypedData(mailTypedData(), signature)
+	assert.Nil(t, err, "Failed to recover address")
+	assert.Equal(t, mailAddress, address, "Did not receive expected address")
+}
+
+func TestRecoverTypedDataInvalidSignature(t *testing.T) {
+	_, err := RecoverTypedData(mailTypedData(), mailSignature[:64])
+	assert.NotNil(t, err, "Recovered address from short signature")
+
+	signature := common.CopyBytes(mailSignature)
+	signature[64] = 29
+	_, err = RecoverTypedData(mailTypedData(), signature)
+	assert.NotNil(t, err, "Recovered address with invalid recovery ID")
+}
+
+func TestVerifyTypedData(t *testing.T) {
+	verified, err := VerifyTypedData(mailTypedData(), mailSignature, mailAddress)
+	assert.Nil(t, err, "Failed to verify typed data")
+	assert.True(t, verified, "Failed to verify signature")
+
+	verified, err = VerifyTypedData(mailTypedData(), mailSignature, testAddress)
+	assert.Nil(t, err, "Failed to verify typed data")
+	assert.False(t, verified, "Verified signature for wrong address")
+
+	data := mailTypedData()
+	data.Message["contents"] = "Hello, Alice!"
+	verified, err = VerifyTypedData(data, mailSignature, mailAddress)
+	assert.Nil(t, err, "Failed to verify typed data")
+	assert.False(t, verified, "Verified signature for altered message")
+}
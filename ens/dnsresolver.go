@@ -50,6 +50,29 @@ func CreateDnsResolverSession(chainID *big.Int, wallet *accounts.Wallet, account
 	return session
 }
 
+// CreateDnsResolverSessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateDnsResolverSessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *dnsresolvercontract.DnsResolverContract, gasPrice *big.Int) *dnsresolvercontract.DnsResolverContractSession {
+	return &dnsresolvercontract.DnsResolverContractSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateDnsResolverSessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateDnsResolverSessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *dnsresolvercontract.DnsResolverContract, gasPrice *big.Int) *dnsresolvercontract.DnsResolverContractSession {
+	session := CreateDnsResolverSessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
+
 // SetDns sets a DNS resolution
 func SetDns(session *dnsresolvercontract.DnsResolverContractSession, name string, rrType uint16, key string, data []byte) (tx *types.Transaction, err error) {
 	tx, err = session.SetDns(NameHash(name), rrType, key, data)
@@ -4,22 +4,49 @@
 package dnsresolvercontract
 
 import (
+	"context"
+	"errors"
 	"math/big"
 	"strings"
+	"time"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 )
 
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+	_ = time.Tick
+	_ = context.Background
+)
+
+// DnsResolverContractMetaData contains all meta data concerning the DnsResolverContract contract.
+var DnsResolverContractMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":true,\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"key\",\"type\":\"string\"},{\"name\":\"value\",\"type\":\"string\"}],\"name\":\"setText\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"contentTypes\",\"type\":\"uint256\"}],\"name\":\"ABI\",\"outputs\":[{\"name\":\"contentType\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"x\",\"type\":\"bytes32\"},{\"name\":\"y\",\"type\":\"bytes32\"}],\"name\":\"setPubkey\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"content\",\"outputs\":[{\"name\":\"ret\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"addr\",\"outputs\":[{\"name\":\"ret\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"key\",\"type\":\"string\"}],\"name\":\"text\",\"outputs\":[{\"name\":\"ret\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"contentType\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"setABI\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"name\",\"outputs\":[{\"name\":\"ret\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"rr\",\"type\":\"uint16\"},{\"name\":\"key\",\"type\":\"string\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"setDns\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"},{\"name\":\"\",\"type\":\"uint16\"},{\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"dnsRecords\",\"outputs\":[{\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"rr\",\"type\":\"uint16\"},{\"name\":\"key\",\"type\":\"string\"}],\"name\":\"dns\",\"outputs\":[{\"name\":\"data\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"setContent\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"pubkey\",\"outputs\":[{\"name\":\"x\",\"type\":\"bytes32\"},{\"name\":\"y\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setAddr\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_registry\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"a\",\"type\":\"address\"}],\"name\":\"AddrChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"ContentChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"name\",\"type\":\"string\"}],\"name\":\"NameChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":true,\"name\":\"contentType\",\"type\":\"uint256\"}],\"name\":\"ABIChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"x\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"y\",\"type\":\"bytes32\"}],\"name\":\"PubkeyChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":true,\"name\":\"indexedKey\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"key\",\"type\":\"string\"}],\"name\":\"TextChanged\",\"type\":\"event\"}]",
+}
+
 // DnsResolverContractABI is the input ABI used to generate the binding from.
-const DnsResolverContractABI = "[{\"constant\":true,\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"key\",\"type\":\"string\"},{\"name\":\"value\",\"type\":\"string\"}],\"name\":\"setText\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"contentTypes\",\"type\":\"uint256\"}],\"name\":\"ABI\",\"outputs\":[{\"name\":\"contentType\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"x\",\"type\":\"bytes32\"},{\"name\":\"y\",\"type\":\"bytes32\"}],\"name\":\"setPubkey\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"content\",\"outputs\":[{\"name\":\"ret\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"addr\",\"outputs\":[{\"name\":\"ret\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"key\",\"type\":\"string\"}],\"name\":\"text\",\"outputs\":[{\"name\":\"ret\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"contentType\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"setABI\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"name\",\"outputs\":[{\"name\":\"ret\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"rr\",\"type\":\"uint16\"},{\"name\":\"key\",\"type\":\"string\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"setDns\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"},{\"name\":\"\",\"type\":\"uint16\"},{\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"dnsRecords\",\"outputs\":[{\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"rr\",\"type\":\"uint16\"},{\"name\":\"key\",\"type\":\"string\"}],\"name\":\"dns\",\"outputs\":[{\"name\":\"data\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"setContent\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"name\":\"pubkey\",\"outputs\":[{\"name\":\"x\",\"type\":\"bytes32\"},{\"name\":\"y\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setAddr\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_registry\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"a\",\"type\":\"address\"}],\"name\":\"AddrChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"ContentChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"name\",\"type\":\"string\"}],\"name\":\"NameChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":true,\"name\":\"contentType\",\"type\":\"uint256\"}],\"name\":\"ABIChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"x\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"y\",\"type\":\"bytes32\"}],\"name\":\"PubkeyChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"node\",\"type\":\"bytes32\"},{\"indexed\":true,\"name\":\"indexedKey\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"key\",\"type\":\"string\"}],\"name\":\"TextChanged\",\"type\":\"event\"}]"
+// Deprecated: Use DnsResolverContractMetaData.ABI instead.
+var DnsResolverContractABI = DnsResolverContractMetaData.ABI
 
 // DnsResolverContract is an auto generated Go binding around an Ethereum contract.
 type DnsResolverContract struct {
 	DnsResolverContractCaller     // Read-only binding to the contract
 	DnsResolverContractTransactor // Write-only binding to the contract
+	DnsResolverContractFilterer   // Log filterer for contract events
 }
 
 // DnsResolverContractCaller is an auto generated read-only Go binding around an Ethereum contract.
@@ -32,6 +59,11 @@ type DnsResolverContractTransactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
+// DnsResolverContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DnsResolverContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
 // DnsResolverContractSession is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
 type DnsResolverContractSession struct {
@@ -71,16 +103,16 @@ type DnsResolverContractTransactorRaw struct {
 
 // NewDnsResolverContract creates a new instance of DnsResolverContract, bound to a specific deployed contract.
 func NewDnsResolverContract(address common.Address, backend bind.ContractBackend) (*DnsResolverContract, error) {
-	contract, err := bindDnsResolverContract(address, backend, backend)
+	contract, err := bindDnsResolverContract(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &DnsResolverContract{DnsResolverContractCaller: DnsResolverContractCaller{contract: contract}, DnsResolverContractTransactor: DnsResolverContractTransactor{contract: contract}}, nil
+	return &DnsResolverContract{DnsResolverContractCaller: DnsResolverContractCaller{contract: contract}, DnsResolverContractTransactor: DnsResolverContractTransactor{contract: contract}, DnsResolverContractFilterer: DnsResolverContractFilterer{contract: contract}}, nil
 }
 
 // NewDnsResolverContractCaller creates a new read-only instance of DnsResolverContract, bound to a specific deployed contract.
 func NewDnsResolverContractCaller(address common.Address, caller bind.ContractCaller) (*DnsResolverContractCaller, error) {
-	contract, err := bindDnsResolverContract(address, caller, nil)
+	contract, err := bindDnsResolverContract(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -89,27 +121,36 @@ func NewDnsResolverContractCaller(address common.Address, caller bind.ContractCa
 
 // NewDnsResolverContractTransactor creates a new write-only instance of DnsResolverContract, bound to a specific deployed contract.
 func NewDnsResolverContractTransactor(address common.Address, transactor bind.ContractTransactor) (*DnsResolverContractTransactor, error) {
-	contract, err := bindDnsResolverContract(address, nil, transactor)
+	contract, err := bindDnsResolverContract(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &DnsResolverContractTransactor{contract: contract}, nil
 }
 
+// NewDnsResolverContractFilterer creates a new log filterer instance of DnsResolverContract, bound to a specific deployed contract.
+func NewDnsResolverContractFilterer(address common.Address, filterer bind.ContractFilterer) (*DnsResolverContractFilterer, error) {
+	contract, err := bindDnsResolverContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractFilterer{contract: contract}, nil
+}
+
 // bindDnsResolverContract binds a generic wrapper to an already deployed contract.
-func bindDnsResolverContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(DnsResolverContractABI))
+func bindDnsResolverContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DnsResolverContractMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, nil), nil
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_DnsResolverContract *DnsResolverContractRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_DnsResolverContract *DnsResolverContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _DnsResolverContract.Contract.DnsResolverContractCaller.contract.Call(opts, result, method, params...)
 }
 
@@ -128,7 +169,7 @@ func (_DnsResolverContract *DnsResolverContractRaw) Transact(opts *bind.Transact
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_DnsResolverContract *DnsResolverContractCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_DnsResolverContract *DnsResolverContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _DnsResolverContract.Contract.contract.Call(opts, result, method, params...)
 }
 
@@ -145,23 +186,32 @@ func (_DnsResolverContract *DnsResolverContractTransactorRaw) Transact(opts *bin
 
 // ABI is a free data retrieval call binding the contract method 0x2203ab56.
 //
-// Solidity: function ABI(node bytes32, contentTypes uint256) constant returns(contentType uint256, data bytes)
+// Solidity: function ABI(bytes32 node, uint256 contentTypes) view returns(uint256 contentType, bytes data)
 func (_DnsResolverContract *DnsResolverContractCaller) ABI(opts *bind.CallOpts, node [32]byte, contentTypes *big.Int) (struct {
 	ContentType *big.Int
 	Data        []byte
 }, error) {
-	ret := new(struct {
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "ABI", node, contentTypes)
+
+	outstruct := new(struct {
 		ContentType *big.Int
 		Data        []byte
 	})
-	out := ret
-	err := _DnsResolverContract.contract.Call(opts, out, "ABI", node, contentTypes)
-	return *ret, err
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ContentType = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Data = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
 }
 
 // ABI is a free data retrieval call binding the contract method 0x2203ab56.
 //
-// Solidity: function ABI(node bytes32, contentTypes uint256) constant returns(contentType uint256, data bytes)
+// Solidity: function ABI(bytes32 node, uint256 contentTypes) view returns(uint256 contentType, bytes data)
 func (_DnsResolverContract *DnsResolverContractSession) ABI(node [32]byte, contentTypes *big.Int) (struct {
 	ContentType *big.Int
 	Data        []byte
@@ -171,7 +221,7 @@ func (_DnsResolverContract *DnsResolverContractSession) ABI(node [32]byte, conte
 
 // ABI is a free data retrieval call binding the contract method 0x2203ab56.
 //
-// Solidity: function ABI(node bytes32, contentTypes uint256) constant returns(contentType uint256, data bytes)
+// Solidity: function ABI(bytes32 node, uint256 contentTypes) view returns(uint256 contentType, bytes data)
 func (_DnsResolverContract *DnsResolverContractCallerSession) ABI(node [32]byte, contentTypes *big.Int) (struct {
 	ContentType *big.Int
 	Data        []byte
@@ -181,153 +231,187 @@ func (_DnsResolverContract *DnsResolverContractCallerSession) ABI(node [32]byte,
 
 // Addr is a free data retrieval call binding the contract method 0x3b3b57de.
 //
-// Solidity: function addr(node bytes32) constant returns(ret address)
+// Solidity: function addr(bytes32 node) view returns(address ret)
 func (_DnsResolverContract *DnsResolverContractCaller) Addr(opts *bind.CallOpts, node [32]byte) (common.Address, error) {
-	var (
-		ret0 = new(common.Address)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "addr", node)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "addr", node)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
 // Addr is a free data retrieval call binding the contract method 0x3b3b57de.
 //
-// Solidity: function addr(node bytes32) constant returns(ret address)
+// Solidity: function addr(bytes32 node) view returns(address ret)
 func (_DnsResolverContract *DnsResolverContractSession) Addr(node [32]byte) (common.Address, error) {
 	return _DnsResolverContract.Contract.Addr(&_DnsResolverContract.CallOpts, node)
 }
 
 // Addr is a free data retrieval call binding the contract method 0x3b3b57de.
 //
-// Solidity: function addr(node bytes32) constant returns(ret address)
+// Solidity: function addr(bytes32 node) view returns(address ret)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Addr(node [32]byte) (common.Address, error) {
 	return _DnsResolverContract.Contract.Addr(&_DnsResolverContract.CallOpts, node)
 }
 
 // Content is a free data retrieval call binding the contract method 0x2dff6941.
 //
-// Solidity: function content(node bytes32) constant returns(ret bytes32)
+// Solidity: function content(bytes32 node) view returns(bytes32 ret)
 func (_DnsResolverContract *DnsResolverContractCaller) Content(opts *bind.CallOpts, node [32]byte) ([32]byte, error) {
-	var (
-		ret0 = new([32]byte)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "content", node)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "content", node)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
 }
 
 // Content is a free data retrieval call binding the contract method 0x2dff6941.
 //
-// Solidity: function content(node bytes32) constant returns(ret bytes32)
+// Solidity: function content(bytes32 node) view returns(bytes32 ret)
 func (_DnsResolverContract *DnsResolverContractSession) Content(node [32]byte) ([32]byte, error) {
 	return _DnsResolverContract.Contract.Content(&_DnsResolverContract.CallOpts, node)
 }
 
 // Content is a free data retrieval call binding the contract method 0x2dff6941.
 //
-// Solidity: function content(node bytes32) constant returns(ret bytes32)
+// Solidity: function content(bytes32 node) view returns(bytes32 ret)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Content(node [32]byte) ([32]byte, error) {
 	return _DnsResolverContract.Contract.Content(&_DnsResolverContract.CallOpts, node)
 }
 
 // Dns is a free data retrieval call binding the contract method 0xaf6e6e9e.
 //
-// Solidity: function dns(node bytes32, rr uint16, key string) constant returns(data bytes)
+// Solidity: function dns(bytes32 node, uint16 rr, string key) view returns(bytes data)
 func (_DnsResolverContract *DnsResolverContractCaller) Dns(opts *bind.CallOpts, node [32]byte, rr uint16, key string) ([]byte, error) {
-	var (
-		ret0 = new([]byte)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "dns", node, rr, key)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "dns", node, rr, key)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
 }
 
 // Dns is a free data retrieval call binding the contract method 0xaf6e6e9e.
 //
-// Solidity: function dns(node bytes32, rr uint16, key string) constant returns(data bytes)
+// Solidity: function dns(bytes32 node, uint16 rr, string key) view returns(bytes data)
 func (_DnsResolverContract *DnsResolverContractSession) Dns(node [32]byte, rr uint16, key string) ([]byte, error) {
 	return _DnsResolverContract.Contract.Dns(&_DnsResolverContract.CallOpts, node, rr, key)
 }
 
 // Dns is a free data retrieval call binding the contract method 0xaf6e6e9e.
 //
-// Solidity: function dns(node bytes32, rr uint16, key string) constant returns(data bytes)
+// Solidity: function dns(bytes32 node, uint16 rr, string key) view returns(bytes data)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Dns(node [32]byte, rr uint16, key string) ([]byte, error) {
 	return _DnsResolverContract.Contract.Dns(&_DnsResolverContract.CallOpts, node, rr, key)
 }
 
 // DnsRecords is a free data retrieval call binding the contract method 0x75333d28.
 //
-// Solidity: function dnsRecords( bytes32,  uint16,  bytes32) constant returns(bytes)
+// Solidity: function dnsRecords(bytes32 , uint16 , bytes32 ) view returns(bytes)
 func (_DnsResolverContract *DnsResolverContractCaller) DnsRecords(opts *bind.CallOpts, arg0 [32]byte, arg1 uint16, arg2 [32]byte) ([]byte, error) {
-	var (
-		ret0 = new([]byte)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "dnsRecords", arg0, arg1, arg2)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "dnsRecords", arg0, arg1, arg2)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
 }
 
 // DnsRecords is a free data retrieval call binding the contract method 0x75333d28.
 //
-// Solidity: function dnsRecords( bytes32,  uint16,  bytes32) constant returns(bytes)
+// Solidity: function dnsRecords(bytes32 , uint16 , bytes32 ) view returns(bytes)
 func (_DnsResolverContract *DnsResolverContractSession) DnsRecords(arg0 [32]byte, arg1 uint16, arg2 [32]byte) ([]byte, error) {
 	return _DnsResolverContract.Contract.DnsRecords(&_DnsResolverContract.CallOpts, arg0, arg1, arg2)
 }
 
 // DnsRecords is a free data retrieval call binding the contract method 0x75333d28.
 //
-// Solidity: function dnsRecords( bytes32,  uint16,  bytes32) constant returns(bytes)
+// Solidity: function dnsRecords(bytes32 , uint16 , bytes32 ) view returns(bytes)
 func (_DnsResolverContract *DnsResolverContractCallerSession) DnsRecords(arg0 [32]byte, arg1 uint16, arg2 [32]byte) ([]byte, error) {
 	return _DnsResolverContract.Contract.DnsRecords(&_DnsResolverContract.CallOpts, arg0, arg1, arg2)
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name(node bytes32) constant returns(ret string)
+// Solidity: function name(bytes32 node) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractCaller) Name(opts *bind.CallOpts, node [32]byte) (string, error) {
-	var (
-		ret0 = new(string)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "name", node)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "name", node)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name(node bytes32) constant returns(ret string)
+// Solidity: function name(bytes32 node) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractSession) Name(node [32]byte) (string, error) {
 	return _DnsResolverContract.Contract.Name(&_DnsResolverContract.CallOpts, node)
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name(node bytes32) constant returns(ret string)
+// Solidity: function name(bytes32 node) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Name(node [32]byte) (string, error) {
 	return _DnsResolverContract.Contract.Name(&_DnsResolverContract.CallOpts, node)
 }
 
 // Pubkey is a free data retrieval call binding the contract method 0xc8690233.
 //
-// Solidity: function pubkey(node bytes32) constant returns(x bytes32, y bytes32)
+// Solidity: function pubkey(bytes32 node) view returns(bytes32 x, bytes32 y)
 func (_DnsResolverContract *DnsResolverContractCaller) Pubkey(opts *bind.CallOpts, node [32]byte) (struct {
 	X [32]byte
 	Y [32]byte
 }, error) {
-	ret := new(struct {
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "pubkey", node)
+
+	outstruct := new(struct {
 		X [32]byte
 		Y [32]byte
 	})
-	out := ret
-	err := _DnsResolverContract.contract.Call(opts, out, "pubkey", node)
-	return *ret, err
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.X = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Y = *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+
+	return *outstruct, err
+
 }
 
 // Pubkey is a free data retrieval call binding the contract method 0xc8690233.
 //
-// Solidity: function pubkey(node bytes32) constant returns(x bytes32, y bytes32)
+// Solidity: function pubkey(bytes32 node) view returns(bytes32 x, bytes32 y)
 func (_DnsResolverContract *DnsResolverContractSession) Pubkey(node [32]byte) (struct {
 	X [32]byte
 	Y [32]byte
@@ -337,7 +421,7 @@ func (_DnsResolverContract *DnsResolverContractSession) Pubkey(node [32]byte) (s
 
 // Pubkey is a free data retrieval call binding the contract method 0xc8690233.
 //
-// Solidity: function pubkey(node bytes32) constant returns(x bytes32, y bytes32)
+// Solidity: function pubkey(bytes32 node) view returns(bytes32 x, bytes32 y)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Pubkey(node [32]byte) (struct {
 	X [32]byte
 	Y [32]byte
@@ -347,199 +431,1121 @@ func (_DnsResolverContract *DnsResolverContractCallerSession) Pubkey(node [32]by
 
 // SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function supportsInterface(interfaceId bytes4) constant returns(bool)
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
 func (_DnsResolverContract *DnsResolverContractCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
-	var (
-		ret0 = new(bool)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "supportsInterface", interfaceId)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
 }
 
 // SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function supportsInterface(interfaceId bytes4) constant returns(bool)
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
 func (_DnsResolverContract *DnsResolverContractSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
 	return _DnsResolverContract.Contract.SupportsInterface(&_DnsResolverContract.CallOpts, interfaceId)
 }
 
 // SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function supportsInterface(interfaceId bytes4) constant returns(bool)
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
 func (_DnsResolverContract *DnsResolverContractCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
 	return _DnsResolverContract.Contract.SupportsInterface(&_DnsResolverContract.CallOpts, interfaceId)
 }
 
 // Text is a free data retrieval call binding the contract method 0x59d1d43c.
 //
-// Solidity: function text(node bytes32, key string) constant returns(ret string)
+// Solidity: function text(bytes32 node, string key) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractCaller) Text(opts *bind.CallOpts, node [32]byte, key string) (string, error) {
-	var (
-		ret0 = new(string)
-	)
-	out := ret0
-	err := _DnsResolverContract.contract.Call(opts, out, "text", node, key)
-	return *ret0, err
+	var out []interface{}
+	err := _DnsResolverContract.contract.Call(opts, &out, "text", node, key)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
 }
 
 // Text is a free data retrieval call binding the contract method 0x59d1d43c.
 //
-// Solidity: function text(node bytes32, key string) constant returns(ret string)
+// Solidity: function text(bytes32 node, string key) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractSession) Text(node [32]byte, key string) (string, error) {
 	return _DnsResolverContract.Contract.Text(&_DnsResolverContract.CallOpts, node, key)
 }
 
 // Text is a free data retrieval call binding the contract method 0x59d1d43c.
 //
-// Solidity: function text(node bytes32, key string) constant returns(ret string)
+// Solidity: function text(bytes32 node, string key) view returns(string ret)
 func (_DnsResolverContract *DnsResolverContractCallerSession) Text(node [32]byte, key string) (string, error) {
 	return _DnsResolverContract.Contract.Text(&_DnsResolverContract.CallOpts, node, key)
 }
 
 // SetABI is a paid mutator transaction binding the contract method 0x623195b0.
 //
-// Solidity: function setABI(node bytes32, contentType uint256, data bytes) returns()
+// Solidity: function setABI(bytes32 node, uint256 contentType, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetABI(opts *bind.TransactOpts, node [32]byte, contentType *big.Int, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setABI", node, contentType, data)
 }
 
 // SetABI is a paid mutator transaction binding the contract method 0x623195b0.
 //
-// Solidity: function setABI(node bytes32, contentType uint256, data bytes) returns()
+// Solidity: function setABI(bytes32 node, uint256 contentType, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetABI(node [32]byte, contentType *big.Int, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetABI(&_DnsResolverContract.TransactOpts, node, contentType, data)
 }
 
 // SetABI is a paid mutator transaction binding the contract method 0x623195b0.
 //
-// Solidity: function setABI(node bytes32, contentType uint256, data bytes) returns()
+// Solidity: function setABI(bytes32 node, uint256 contentType, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetABI(node [32]byte, contentType *big.Int, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetABI(&_DnsResolverContract.TransactOpts, node, contentType, data)
 }
 
 // SetAddr is a paid mutator transaction binding the contract method 0xd5fa2b00.
 //
-// Solidity: function setAddr(node bytes32, addr address) returns()
+// Solidity: function setAddr(bytes32 node, address addr) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetAddr(opts *bind.TransactOpts, node [32]byte, addr common.Address) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setAddr", node, addr)
 }
 
 // SetAddr is a paid mutator transaction binding the contract method 0xd5fa2b00.
 //
-// Solidity: function setAddr(node bytes32, addr address) returns()
+// Solidity: function setAddr(bytes32 node, address addr) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetAddr(node [32]byte, addr common.Address) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetAddr(&_DnsResolverContract.TransactOpts, node, addr)
 }
 
 // SetAddr is a paid mutator transaction binding the contract method 0xd5fa2b00.
 //
-// Solidity: function setAddr(node bytes32, addr address) returns()
+// Solidity: function setAddr(bytes32 node, address addr) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetAddr(node [32]byte, addr common.Address) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetAddr(&_DnsResolverContract.TransactOpts, node, addr)
 }
 
 // SetContent is a paid mutator transaction binding the contract method 0xc3d014d6.
 //
-// Solidity: function setContent(node bytes32, hash bytes32) returns()
+// Solidity: function setContent(bytes32 node, bytes32 hash) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetContent(opts *bind.TransactOpts, node [32]byte, hash [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setContent", node, hash)
 }
 
 // SetContent is a paid mutator transaction binding the contract method 0xc3d014d6.
 //
-// Solidity: function setContent(node bytes32, hash bytes32) returns()
+// Solidity: function setContent(bytes32 node, bytes32 hash) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetContent(node [32]byte, hash [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetContent(&_DnsResolverContract.TransactOpts, node, hash)
 }
 
 // SetContent is a paid mutator transaction binding the contract method 0xc3d014d6.
 //
-// Solidity: function setContent(node bytes32, hash bytes32) returns()
+// Solidity: function setContent(bytes32 node, bytes32 hash) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetContent(node [32]byte, hash [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetContent(&_DnsResolverContract.TransactOpts, node, hash)
 }
 
 // SetDns is a paid mutator transaction binding the contract method 0x6cf433e7.
 //
-// Solidity: function setDns(node bytes32, rr uint16, key string, data bytes) returns()
+// Solidity: function setDns(bytes32 node, uint16 rr, string key, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetDns(opts *bind.TransactOpts, node [32]byte, rr uint16, key string, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setDns", node, rr, key, data)
 }
 
 // SetDns is a paid mutator transaction binding the contract method 0x6cf433e7.
 //
-// Solidity: function setDns(node bytes32, rr uint16, key string, data bytes) returns()
+// Solidity: function setDns(bytes32 node, uint16 rr, string key, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetDns(node [32]byte, rr uint16, key string, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetDns(&_DnsResolverContract.TransactOpts, node, rr, key, data)
 }
 
 // SetDns is a paid mutator transaction binding the contract method 0x6cf433e7.
 //
-// Solidity: function setDns(node bytes32, rr uint16, key string, data bytes) returns()
+// Solidity: function setDns(bytes32 node, uint16 rr, string key, bytes data) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetDns(node [32]byte, rr uint16, key string, data []byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetDns(&_DnsResolverContract.TransactOpts, node, rr, key, data)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, name string) returns()
+// Solidity: function setName(bytes32 node, string name) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetName(opts *bind.TransactOpts, node [32]byte, name string) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setName", node, name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, name string) returns()
+// Solidity: function setName(bytes32 node, string name) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetName(node [32]byte, name string) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetName(&_DnsResolverContract.TransactOpts, node, name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, name string) returns()
+// Solidity: function setName(bytes32 node, string name) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetName(node [32]byte, name string) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetName(&_DnsResolverContract.TransactOpts, node, name)
 }
 
 // SetPubkey is a paid mutator transaction binding the contract method 0x29cd62ea.
 //
-// Solidity: function setPubkey(node bytes32, x bytes32, y bytes32) returns()
+// Solidity: function setPubkey(bytes32 node, bytes32 x, bytes32 y) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetPubkey(opts *bind.TransactOpts, node [32]byte, x [32]byte, y [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setPubkey", node, x, y)
 }
 
 // SetPubkey is a paid mutator transaction binding the contract method 0x29cd62ea.
 //
-// Solidity: function setPubkey(node bytes32, x bytes32, y bytes32) returns()
+// Solidity: function setPubkey(bytes32 node, bytes32 x, bytes32 y) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetPubkey(node [32]byte, x [32]byte, y [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetPubkey(&_DnsResolverContract.TransactOpts, node, x, y)
 }
 
 // SetPubkey is a paid mutator transaction binding the contract method 0x29cd62ea.
 //
-// Solidity: function setPubkey(node bytes32, x bytes32, y bytes32) returns()
+// Solidity: function setPubkey(bytes32 node, bytes32 x, bytes32 y) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetPubkey(node [32]byte, x [32]byte, y [32]byte) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetPubkey(&_DnsResolverContract.TransactOpts, node, x, y)
 }
 
 // SetText is a paid mutator transaction binding the contract method 0x10f13a8c.
 //
-// Solidity: function setText(node bytes32, key string, value string) returns()
+// Solidity: function setText(bytes32 node, string key, string value) returns()
 func (_DnsResolverContract *DnsResolverContractTransactor) SetText(opts *bind.TransactOpts, node [32]byte, key string, value string) (*types.Transaction, error) {
 	return _DnsResolverContract.contract.Transact(opts, "setText", node, key, value)
 }
 
 // SetText is a paid mutator transaction binding the contract method 0x10f13a8c.
 //
-// Solidity: function setText(node bytes32, key string, value string) returns()
+// Solidity: function setText(bytes32 node, string key, string value) returns()
 func (_DnsResolverContract *DnsResolverContractSession) SetText(node [32]byte, key string, value string) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetText(&_DnsResolverContract.TransactOpts, node, key, value)
 }
 
 // SetText is a paid mutator transaction binding the contract method 0x10f13a8c.
 //
-// Solidity: function setText(node bytes32, key string, value string) returns()
+// Solidity: function setText(bytes32 node, string key, string value) returns()
 func (_DnsResolverContract *DnsResolverContractTransactorSession) SetText(node [32]byte, key string, value string) (*types.Transaction, error) {
 	return _DnsResolverContract.Contract.SetText(&_DnsResolverContract.TransactOpts, node, key, value)
 }
+
+// DnsResolverContractABIChangedIterator is returned from FilterABIChanged and is used to iterate over the raw logs and unpacked data for ABIChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractABIChangedIterator struct {
+	Event *DnsResolverContractABIChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractABIChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractABIChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractABIChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractABIChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractABIChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractABIChanged represents a ABIChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractABIChanged struct {
+	Node        [32]byte
+	ContentType *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterABIChanged is a free log retrieval operation binding the contract event 0xaa121bbeef5f32f5961a2a28966e769023910fc9479059ee3495d4c1a696efe3.
+//
+// Solidity: event ABIChanged(bytes32 indexed node, uint256 indexed contentType)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterABIChanged(opts *bind.FilterOpts, node [][32]byte, contentType []*big.Int) (*DnsResolverContractABIChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+	var contentTypeRule []interface{}
+	for _, contentTypeItem := range contentType {
+		contentTypeRule = append(contentTypeRule, contentTypeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "ABIChanged", nodeRule, contentTypeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractABIChangedIterator{contract: _DnsResolverContract.contract, event: "ABIChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchABIChanged is a free log subscription operation binding the contract event 0xaa121bbeef5f32f5961a2a28966e769023910fc9479059ee3495d4c1a696efe3.
+//
+// Solidity: event ABIChanged(bytes32 indexed node, uint256 indexed contentType)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchABIChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractABIChanged, node [][32]byte, contentType []*big.Int) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+	var contentTypeRule []interface{}
+	for _, contentTypeItem := range contentType {
+		contentTypeRule = append(contentTypeRule, contentTypeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "ABIChanged", nodeRule, contentTypeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractABIChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "ABIChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseABIChanged is a log parse operation binding the contract event 0xaa121bbeef5f32f5961a2a28966e769023910fc9479059ee3495d4c1a696efe3.
+//
+// Solidity: event ABIChanged(bytes32 indexed node, uint256 indexed contentType)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParseABIChanged(log types.Log) (*DnsResolverContractABIChanged, error) {
+	event := new(DnsResolverContractABIChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "ABIChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DnsResolverContractAddrChangedIterator is returned from FilterAddrChanged and is used to iterate over the raw logs and unpacked data for AddrChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractAddrChangedIterator struct {
+	Event *DnsResolverContractAddrChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractAddrChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractAddrChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractAddrChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractAddrChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractAddrChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractAddrChanged represents a AddrChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractAddrChanged struct {
+	Node [32]byte
+	A    common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddrChanged is a free log retrieval operation binding the contract event 0x52d7d861f09ab3d26239d492e8968629f95e9e318cf0b73bfddc441522a15fd2.
+//
+// Solidity: event AddrChanged(bytes32 indexed node, address a)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterAddrChanged(opts *bind.FilterOpts, node [][32]byte) (*DnsResolverContractAddrChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "AddrChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractAddrChangedIterator{contract: _DnsResolverContract.contract, event: "AddrChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAddrChanged is a free log subscription operation binding the contract event 0x52d7d861f09ab3d26239d492e8968629f95e9e318cf0b73bfddc441522a15fd2.
+//
+// Solidity: event AddrChanged(bytes32 indexed node, address a)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchAddrChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractAddrChanged, node [][32]byte) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "AddrChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractAddrChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "AddrChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddrChanged is a log parse operation binding the contract event 0x52d7d861f09ab3d26239d492e8968629f95e9e318cf0b73bfddc441522a15fd2.
+//
+// Solidity: event AddrChanged(bytes32 indexed node, address a)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParseAddrChanged(log types.Log) (*DnsResolverContractAddrChanged, error) {
+	event := new(DnsResolverContractAddrChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "AddrChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DnsResolverContractContentChangedIterator is returned from FilterContentChanged and is used to iterate over the raw logs and unpacked data for ContentChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractContentChangedIterator struct {
+	Event *DnsResolverContractContentChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractContentChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractContentChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractContentChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractContentChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractContentChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractContentChanged represents a ContentChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractContentChanged struct {
+	Node [32]byte
+	Hash [32]byte
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterContentChanged is a free log retrieval operation binding the contract event 0x0424b6fe0d9c3bdbece0e7879dc241bb0c22e900be8b6c168b4ee08bd9bf83bc.
+//
+// Solidity: event ContentChanged(bytes32 indexed node, bytes32 hash)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterContentChanged(opts *bind.FilterOpts, node [][32]byte) (*DnsResolverContractContentChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "ContentChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractContentChangedIterator{contract: _DnsResolverContract.contract, event: "ContentChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchContentChanged is a free log subscription operation binding the contract event 0x0424b6fe0d9c3bdbece0e7879dc241bb0c22e900be8b6c168b4ee08bd9bf83bc.
+//
+// Solidity: event ContentChanged(bytes32 indexed node, bytes32 hash)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchContentChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractContentChanged, node [][32]byte) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "ContentChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractContentChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "ContentChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContentChanged is a log parse operation binding the contract event 0x0424b6fe0d9c3bdbece0e7879dc241bb0c22e900be8b6c168b4ee08bd9bf83bc.
+//
+// Solidity: event ContentChanged(bytes32 indexed node, bytes32 hash)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParseContentChanged(log types.Log) (*DnsResolverContractContentChanged, error) {
+	event := new(DnsResolverContractContentChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "ContentChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DnsResolverContractNameChangedIterator is returned from FilterNameChanged and is used to iterate over the raw logs and unpacked data for NameChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractNameChangedIterator struct {
+	Event *DnsResolverContractNameChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractNameChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractNameChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractNameChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractNameChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractNameChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractNameChanged represents a NameChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractNameChanged struct {
+	Node [32]byte
+	Name string
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterNameChanged is a free log retrieval operation binding the contract event 0xb7d29e911041e8d9b843369e890bcb72c9388692ba48b65ac54e7214c4c348f7.
+//
+// Solidity: event NameChanged(bytes32 indexed node, string name)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterNameChanged(opts *bind.FilterOpts, node [][32]byte) (*DnsResolverContractNameChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "NameChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractNameChangedIterator{contract: _DnsResolverContract.contract, event: "NameChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchNameChanged is a free log subscription operation binding the contract event 0xb7d29e911041e8d9b843369e890bcb72c9388692ba48b65ac54e7214c4c348f7.
+//
+// Solidity: event NameChanged(bytes32 indexed node, string name)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchNameChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractNameChanged, node [][32]byte) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "NameChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractNameChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "NameChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNameChanged is a log parse operation binding the contract event 0xb7d29e911041e8d9b843369e890bcb72c9388692ba48b65ac54e7214c4c348f7.
+//
+// Solidity: event NameChanged(bytes32 indexed node, string name)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParseNameChanged(log types.Log) (*DnsResolverContractNameChanged, error) {
+	event := new(DnsResolverContractNameChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "NameChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DnsResolverContractPubkeyChangedIterator is returned from FilterPubkeyChanged and is used to iterate over the raw logs and unpacked data for PubkeyChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractPubkeyChangedIterator struct {
+	Event *DnsResolverContractPubkeyChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractPubkeyChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractPubkeyChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractPubkeyChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractPubkeyChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractPubkeyChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractPubkeyChanged represents a PubkeyChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractPubkeyChanged struct {
+	Node [32]byte
+	X    [32]byte
+	Y    [32]byte
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterPubkeyChanged is a free log retrieval operation binding the contract event 0x1d6f5e03d3f63eb58751986629a5439baee5079ff04f345becb66e23eb154e46.
+//
+// Solidity: event PubkeyChanged(bytes32 indexed node, bytes32 x, bytes32 y)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterPubkeyChanged(opts *bind.FilterOpts, node [][32]byte) (*DnsResolverContractPubkeyChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "PubkeyChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractPubkeyChangedIterator{contract: _DnsResolverContract.contract, event: "PubkeyChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchPubkeyChanged is a free log subscription operation binding the contract event 0x1d6f5e03d3f63eb58751986629a5439baee5079ff04f345becb66e23eb154e46.
+//
+// Solidity: event PubkeyChanged(bytes32 indexed node, bytes32 x, bytes32 y)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchPubkeyChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractPubkeyChanged, node [][32]byte) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "PubkeyChanged", nodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractPubkeyChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "PubkeyChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePubkeyChanged is a log parse operation binding the contract event 0x1d6f5e03d3f63eb58751986629a5439baee5079ff04f345becb66e23eb154e46.
+//
+// Solidity: event PubkeyChanged(bytes32 indexed node, bytes32 x, bytes32 y)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParsePubkeyChanged(log types.Log) (*DnsResolverContractPubkeyChanged, error) {
+	event := new(DnsResolverContractPubkeyChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "PubkeyChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DnsResolverContractTextChangedIterator is returned from FilterTextChanged and is used to iterate over the raw logs and unpacked data for TextChanged events raised by the DnsResolverContract contract.
+type DnsResolverContractTextChangedIterator struct {
+	Event *DnsResolverContractTextChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DnsResolverContractTextChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DnsResolverContractTextChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DnsResolverContractTextChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DnsResolverContractTextChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DnsResolverContractTextChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DnsResolverContractTextChanged represents a TextChanged event raised by the DnsResolverContract contract.
+type DnsResolverContractTextChanged struct {
+	Node       [32]byte
+	IndexedKey common.Hash
+	Key        string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterTextChanged is a free log retrieval operation binding the contract event 0xd8c9334b1a9c2f9da342a0a2b32629c1a229b6445dad78947f674b44444a7550.
+//
+// Solidity: event TextChanged(bytes32 indexed node, string indexed indexedKey, string key)
+func (_DnsResolverContract *DnsResolverContractFilterer) FilterTextChanged(opts *bind.FilterOpts, node [][32]byte, indexedKey []string) (*DnsResolverContractTextChangedIterator, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+	var indexedKeyRule []interface{}
+	for _, indexedKeyItem := range indexedKey {
+		indexedKeyRule = append(indexedKeyRule, indexedKeyItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.FilterLogs(opts, "TextChanged", nodeRule, indexedKeyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DnsResolverContractTextChangedIterator{contract: _DnsResolverContract.contract, event: "TextChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchTextChanged is a free log subscription operation binding the contract event 0xd8c9334b1a9c2f9da342a0a2b32629c1a229b6445dad78947f674b44444a7550.
+//
+// Solidity: event TextChanged(bytes32 indexed node, string indexed indexedKey, string key)
+func (_DnsResolverContract *DnsResolverContractFilterer) WatchTextChanged(opts *bind.WatchOpts, sink chan<- *DnsResolverContractTextChanged, node [][32]byte, indexedKey []string) (event.Subscription, error) {
+
+	var nodeRule []interface{}
+	for _, nodeItem := range node {
+		nodeRule = append(nodeRule, nodeItem)
+	}
+	var indexedKeyRule []interface{}
+	for _, indexedKeyItem := range indexedKey {
+		indexedKeyRule = append(indexedKeyRule, indexedKeyItem)
+	}
+
+	logs, sub, err := _DnsResolverContract.contract.WatchLogs(opts, "TextChanged", nodeRule, indexedKeyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DnsResolverContractTextChanged)
+				if err := _DnsResolverContract.contract.UnpackLog(event, "TextChanged", log); err != nil {
+					// If the signature doesn't match, skip this log.
+					if errors.Is(err, bind.ErrEventSignatureMismatch) {
+						continue
+					}
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTextChanged is a log parse operation binding the contract event 0xd8c9334b1a9c2f9da342a0a2b32629c1a229b6445dad78947f674b44444a7550.
+//
+// Solidity: event TextChanged(bytes32 indexed node, string indexed indexedKey, string key)
+func (_DnsResolverContract *DnsResolverContractFilterer) ParseTextChanged(log types.Log) (*DnsResolverContractTextChanged, error) {
+	event := new(DnsResolverContractTextChanged)
+	if err := _DnsResolverContract.contract.UnpackLog(event, "TextChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
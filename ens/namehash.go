@@ -20,7 +20,7 @@ import (
 
 	"golang.org/x/net/idna"
 
-	"github.com/ethereum/go-ethereum/crypto/sha3"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var p = idna.New(idna.MapForLookup(), idna.StrictDomainName(true), idna.Transitional(false))
@@ -46,7 +46,7 @@ func LabelHash(label string) (hash [32]byte) {
 	}
 	normalizedLabel := Normalize(label)
 
-	sha := sha3.NewKeccak256()
+	sha := crypto.NewKeccakState()
 	sha.Write([]byte(normalizedLabel))
 	sha.Sum(hash[:0])
 	return
@@ -80,9 +80,9 @@ func Domain(name string) (domain string, err error) {
 }
 
 func nameHashPart(currentHash [32]byte, name string) (hash [32]byte) {
-	sha := sha3.NewKeccak256()
+	sha := crypto.NewKeccakState()
 	sha.Write(currentHash[:])
-	nameSha := sha3.NewKeccak256()
+	nameSha := crypto.NewKeccakState()
 	nameSha.Write([]byte(name))
 	nameHash := nameSha.Sum(nil)
 	sha.Write(nameHash)
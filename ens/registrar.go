@@ -25,7 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto/sha3"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	etherutils "github.com/orinocopay/go-etherutils"
 	"github.com/orinocopay/go-etherutils/ens/registrarcontract"
@@ -93,6 +93,29 @@ func CreateRegistrarSession(chainID *big.Int, wallet *accounts.Wallet, account *
 	return session
 }
 
+// CreateRegistrarSessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateRegistrarSessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *registrarcontract.RegistrarContract, gasPrice *big.Int) *registrarcontract.RegistrarContractSession {
+	return &registrarcontract.RegistrarContractSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateRegistrarSessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateRegistrarSessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *registrarcontract.RegistrarContract, gasPrice *big.Int) *registrarcontract.RegistrarContractSession {
+	session := CreateRegistrarSessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
+
 // SealBid seals the elements of a bid in to a single hash
 func SealBid(name string, owner *common.Address, amount big.Int, salt string) (hash common.Hash, err error) {
 	domain, err := Domain(name)
@@ -102,7 +125,7 @@ func SealBid(name string, owner *common.Address, amount big.Int, salt string) (h
 	}
 	domainHash := LabelHash(domain)
 
-	sha := sha3.NewKeccak256()
+	sha := crypto.NewKeccakState()
 	sha.Write(domainHash[:])
 	sha.Write(owner.Bytes())
 	// Amount needs to be exactly 32 bytes
@@ -307,7 +330,7 @@ func NameInState(contract *registrarcontract.RegistrarContract, client *ethclien
 
 // Generate a simple hash for a salt
 func saltHash(salt string) (hash [32]byte) {
-	sha := sha3.NewKeccak256()
+	sha := crypto.NewKeccakState()
 	sha.Write([]byte(salt))
 	sha.Sum(hash[:0])
 	return
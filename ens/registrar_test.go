@@ -15,8 +15,11 @@
 package ens
 
 import (
+	"context"
 	"encoding/hex"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	etherutils "github.com/orinocopay/go-etherutils"
@@ -25,7 +28,25 @@ import (
 
 var client, _ = ethclient.Dial("https://ropsten.orinocopay.com:8546/")
 
+var (
+	testNodeOnce sync.Once
+	testNodeErr  error
+)
+
+// requireTestNode skips a test that needs the test node if it cannot be reached
+func requireTestNode(t *testing.T) {
+	testNodeOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, testNodeErr = client.NetworkID(ctx)
+	})
+	if testNodeErr != nil {
+		t.Skipf("Test node unavailable: %v", testNodeErr)
+	}
+}
+
 func TestSealBid1(t *testing.T) {
+	requireTestNode(t)
 	contract, err := RegistrarContract(client)
 	assert.Nil(t, err, "Failed to obtain contract")
 
@@ -33,6 +33,12 @@ import (
 	"github.com/orinocopay/go-etherutils/ens/registrycontract"
 )
 
+// Chain IDs of test networks that go-ethereum no longer describes
+var (
+	ropstenChainID = big.NewInt(3)
+	rinkebyChainID = big.NewInt(4)
+)
+
 func RegistryContractAddress(client *ethclient.Client) (address common.Address, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -44,9 +50,9 @@ func RegistryContractAddress(client *ethclient.Client) (address common.Address,
 	// Instantiate the registry contract
 	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
 		address = common.HexToAddress("314159265dd8dbb310642f98f50c066173c1259b")
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(ropstenChainID) == 0 {
 		address = common.HexToAddress("112234455c3a32fd11230c42e7bccd4a84e02010")
-	} else if chainID.Cmp(params.RinkebyChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(rinkebyChainID) == 0 {
 		address = common.HexToAddress("e7410170f87102DF0055eB195163A03B7F2Bff4A")
 	} else {
 		err = fmt.Errorf("No contract for network ID %v", chainID)
@@ -120,3 +126,26 @@ func CreateRegistrySession(chainID *big.Int, wallet *accounts.Wallet, account *a
 
 	return session
 }
+
+// CreateRegistrySessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateRegistrySessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *registrycontract.RegistryContract, gasPrice *big.Int) *registrycontract.RegistryContractSession {
+	return &registrycontract.RegistryContractSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateRegistrySessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateRegistrySessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *registrycontract.RegistryContract, gasPrice *big.Int) *registrycontract.RegistryContractSession {
+	session := CreateRegistrySessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
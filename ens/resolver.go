@@ -143,6 +143,29 @@ func CreateResolverSession(chainID *big.Int, wallet *accounts.Wallet, account *a
 	return session
 }
 
+// CreateResolverSessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateResolverSessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *resolvercontract.ResolverContract, gasPrice *big.Int) *resolvercontract.ResolverContractSession {
+	return &resolvercontract.ResolverContractSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateResolverSessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateResolverSessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *resolvercontract.ResolverContract, gasPrice *big.Int) *resolvercontract.ResolverContractSession {
+	session := CreateResolverSessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
+
 // SetResolution sets the address to which a name resolves
 func SetResolution(session *resolvercontract.ResolverContractSession, name string, resolutionAddress *common.Address) (tx *types.Transaction, err error) {
 	tx, err = session.SetAddr(NameHash(name), *resolutionAddress)
@@ -33,24 +33,28 @@ func TestResolveZero(t *testing.T) {
 }
 
 func TestResolveNotPresent(t *testing.T) {
+	requireTestNode(t)
 	_, err := Resolve(client, "sirnotappearinginthisregistry.eth")
 	assert.NotNil(t, err, "Resolved name that does not exist")
 	assert.Equal(t, "unregistered name", err.Error(), "Unexpected error")
 }
 
 func TestResolveNoResolver(t *testing.T) {
+	requireTestNode(t)
 	_, err := Resolve(client, "noresolver.eth")
 	assert.NotNil(t, err, "Resolved name without a resolver")
 	assert.Equal(t, "no resolver", err.Error(), "Unexpected error")
 }
 
 func TestResolveBadResolver(t *testing.T) {
+	requireTestNode(t)
 	_, err := Resolve(client, "resolvestozero.eth")
 	assert.NotNil(t, err, "Resolved name with a bad resolver")
 	assert.Equal(t, "no address", err.Error(), "Unexpected error")
 }
 
 func TestResolveTestEnsTest(t *testing.T) {
+	requireTestNode(t)
 	expected := "388ea662ef2c223ec0b047d41bf3c0f362142ad5"
 	actual, err := Resolve(client, "test.enstest.eth")
 	assert.Nil(t, err, "Error resolving name")
@@ -58,6 +62,7 @@ func TestResolveTestEnsTest(t *testing.T) {
 }
 
 func TestResolveResolverEth(t *testing.T) {
+	requireTestNode(t)
 	expected := "5ffc014343cd971b7eb70732021e26c35b744cc4"
 	actual, err := Resolve(client, "resolver.eth")
 	assert.Nil(t, err, "Error resolving name")
@@ -65,6 +70,7 @@ func TestResolveResolverEth(t *testing.T) {
 }
 
 func TestResolveNickJohnson(t *testing.T) {
+	requireTestNode(t)
 	expected := "70abd981e01ad3e6eb1726a5001000877ab04417"
 	actual, err := Resolve(client, "nickjohnson.eth")
 	assert.Nil(t, err, "Error resolving name")
@@ -91,6 +97,7 @@ func TestResolveHexString(t *testing.T) {
 }
 
 func TestReverseResolveTestEnsTest(t *testing.T) {
+	requireTestNode(t)
 	expected := "domainsale"
 	address := common.HexToAddress("0x388ea662ef2c223ec0b047d41bf3c0f362142ad5")
 	actual, err := ReverseResolve(client, &address)
@@ -77,6 +77,29 @@ func CreateReverseRegistrarSession(chainID *big.Int, wallet *accounts.Wallet, ac
 	return session
 }
 
+// CreateReverseRegistrarSessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateReverseRegistrarSessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *reverseregistrarcontract.ReverseRegistrarContract, gasPrice *big.Int) *reverseregistrarcontract.ReverseRegistrarContractSession {
+	return &reverseregistrarcontract.ReverseRegistrarContractSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateReverseRegistrarSessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateReverseRegistrarSessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *reverseregistrarcontract.ReverseRegistrarContract, gasPrice *big.Int) *reverseregistrarcontract.ReverseRegistrarContractSession {
+	session := CreateReverseRegistrarSessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
+
 // SetName sets the name for the sending address
 func SetName(session *reverseregistrarcontract.ReverseRegistrarContractSession, name string) (tx *types.Transaction, err error) {
 	tx, err = session.SetName(name)
@@ -1,24 +1,52 @@
-// This file is an automatically generated Go binding. Do not modify as any
-// change will likely be lost upon the next re-generation!
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
 
 package reverseregistrarcontract
 
 import (
+	"context"
+	"errors"
+	"math/big"
 	"strings"
+	"time"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 )
 
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+	_ = time.Tick
+	_ = context.Background
+)
+
+// ReverseRegistrarContractMetaData contains all meta data concerning the ReverseRegistrarContract contract.
+var ReverseRegistrarContractMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":false,\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"},{\"name\":\"resolver\",\"type\":\"address\"}],\"name\":\"claimWithResolver\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"claim\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ens\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"defaultResolver\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"node\",\"outputs\":[{\"name\":\"ret\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"inputs\":[{\"name\":\"ensAddr\",\"type\":\"address\"},{\"name\":\"resolverAddr\",\"type\":\"address\"}],\"payable\":false,\"type\":\"constructor\"}]",
+}
+
 // ReverseRegistrarContractABI is the input ABI used to generate the binding from.
-const ReverseRegistrarContractABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"},{\"name\":\"resolver\",\"type\":\"address\"}],\"name\":\"claimWithResolver\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"claim\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ens\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"defaultResolver\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"node\",\"outputs\":[{\"name\":\"ret\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[{\"name\":\"node\",\"type\":\"bytes32\"}],\"payable\":false,\"type\":\"function\"},{\"inputs\":[{\"name\":\"ensAddr\",\"type\":\"address\"},{\"name\":\"resolverAddr\",\"type\":\"address\"}],\"payable\":false,\"type\":\"constructor\"}]"
+// Deprecated: Use ReverseRegistrarContractMetaData.ABI instead.
+var ReverseRegistrarContractABI = ReverseRegistrarContractMetaData.ABI
 
 // ReverseRegistrarContract is an auto generated Go binding around an Ethereum contract.
 type ReverseRegistrarContract struct {
 	ReverseRegistrarContractCaller     // Read-only binding to the contract
 	ReverseRegistrarContractTransactor // Write-only binding to the contract
+	ReverseRegistrarContractFilterer   // Log filterer for contract events
 }
 
 // ReverseRegistrarContractCaller is an auto generated read-only Go binding around an Ethereum contract.
@@ -31,6 +59,11 @@ type ReverseRegistrarContractTransactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
+// ReverseRegistrarContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ReverseRegistrarContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
 // ReverseRegistrarContractSession is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
 type ReverseRegistrarContractSession struct {
@@ -70,16 +103,16 @@ type ReverseRegistrarContractTransactorRaw struct {
 
 // NewReverseRegistrarContract creates a new instance of ReverseRegistrarContract, bound to a specific deployed contract.
 func NewReverseRegistrarContract(address common.Address, backend bind.ContractBackend) (*ReverseRegistrarContract, error) {
-	contract, err := bindReverseRegistrarContract(address, backend, backend)
+	contract, err := bindReverseRegistrarContract(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &ReverseRegistrarContract{ReverseRegistrarContractCaller: ReverseRegistrarContractCaller{contract: contract}, ReverseRegistrarContractTransactor: ReverseRegistrarContractTransactor{contract: contract}}, nil
+	return &ReverseRegistrarContract{ReverseRegistrarContractCaller: ReverseRegistrarContractCaller{contract: contract}, ReverseRegistrarContractTransactor: ReverseRegistrarContractTransactor{contract: contract}, ReverseRegistrarContractFilterer: ReverseRegistrarContractFilterer{contract: contract}}, nil
 }
 
 // NewReverseRegistrarContractCaller creates a new read-only instance of ReverseRegistrarContract, bound to a specific deployed contract.
 func NewReverseRegistrarContractCaller(address common.Address, caller bind.ContractCaller) (*ReverseRegistrarContractCaller, error) {
-	contract, err := bindReverseRegistrarContract(address, caller, nil)
+	contract, err := bindReverseRegistrarContract(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,27 +121,36 @@ func NewReverseRegistrarContractCaller(address common.Address, caller bind.Contr
 
 // NewReverseRegistrarContractTransactor creates a new write-only instance of ReverseRegistrarContract, bound to a specific deployed contract.
 func NewReverseRegistrarContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ReverseRegistrarContractTransactor, error) {
-	contract, err := bindReverseRegistrarContract(address, nil, transactor)
+	contract, err := bindReverseRegistrarContract(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &ReverseRegistrarContractTransactor{contract: contract}, nil
 }
 
+// NewReverseRegistrarContractFilterer creates a new log filterer instance of ReverseRegistrarContract, bound to a specific deployed contract.
+func NewReverseRegistrarContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ReverseRegistrarContractFilterer, error) {
+	contract, err := bindReverseRegistrarContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ReverseRegistrarContractFilterer{contract: contract}, nil
+}
+
 // bindReverseRegistrarContract binds a generic wrapper to an already deployed contract.
-func bindReverseRegistrarContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(ReverseRegistrarContractABI))
+func bindReverseRegistrarContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ReverseRegistrarContractMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, nil), nil
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_ReverseRegistrarContract *ReverseRegistrarContractRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_ReverseRegistrarContract *ReverseRegistrarContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _ReverseRegistrarContract.Contract.ReverseRegistrarContractCaller.contract.Call(opts, result, method, params...)
 }
 
@@ -127,7 +169,7 @@ func (_ReverseRegistrarContract *ReverseRegistrarContractRaw) Transact(opts *bin
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_ReverseRegistrarContract *ReverseRegistrarContractCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_ReverseRegistrarContract *ReverseRegistrarContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _ReverseRegistrarContract.Contract.contract.Call(opts, result, method, params...)
 }
 
@@ -144,141 +186,156 @@ func (_ReverseRegistrarContract *ReverseRegistrarContractTransactorRaw) Transact
 
 // DefaultResolver is a free data retrieval call binding the contract method 0x828eab0e.
 //
-// Solidity: function defaultResolver() constant returns(address)
+// Solidity: function defaultResolver() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCaller) DefaultResolver(opts *bind.CallOpts) (common.Address, error) {
-	var (
-		ret0 = new(common.Address)
-	)
-	out := ret0
-	err := _ReverseRegistrarContract.contract.Call(opts, out, "defaultResolver")
-	return *ret0, err
+	var out []interface{}
+	err := _ReverseRegistrarContract.contract.Call(opts, &out, "defaultResolver")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
 // DefaultResolver is a free data retrieval call binding the contract method 0x828eab0e.
 //
-// Solidity: function defaultResolver() constant returns(address)
+// Solidity: function defaultResolver() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) DefaultResolver() (common.Address, error) {
 	return _ReverseRegistrarContract.Contract.DefaultResolver(&_ReverseRegistrarContract.CallOpts)
 }
 
 // DefaultResolver is a free data retrieval call binding the contract method 0x828eab0e.
 //
-// Solidity: function defaultResolver() constant returns(address)
+// Solidity: function defaultResolver() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCallerSession) DefaultResolver() (common.Address, error) {
 	return _ReverseRegistrarContract.Contract.DefaultResolver(&_ReverseRegistrarContract.CallOpts)
 }
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCaller) Ens(opts *bind.CallOpts) (common.Address, error) {
-	var (
-		ret0 = new(common.Address)
-	)
-	out := ret0
-	err := _ReverseRegistrarContract.contract.Call(opts, out, "ens")
-	return *ret0, err
+	var out []interface{}
+	err := _ReverseRegistrarContract.contract.Call(opts, &out, "ens")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) Ens() (common.Address, error) {
 	return _ReverseRegistrarContract.Contract.Ens(&_ReverseRegistrarContract.CallOpts)
 }
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCallerSession) Ens() (common.Address, error) {
 	return _ReverseRegistrarContract.Contract.Ens(&_ReverseRegistrarContract.CallOpts)
 }
 
 // Node is a free data retrieval call binding the contract method 0xbffbe61c.
 //
-// Solidity: function node(addr address) constant returns(ret bytes32)
+// Solidity: function node(address addr) returns(bytes32 ret)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCaller) Node(opts *bind.CallOpts, addr common.Address) ([32]byte, error) {
-	var (
-		ret0 = new([32]byte)
-	)
-	out := ret0
-	err := _ReverseRegistrarContract.contract.Call(opts, out, "node", addr)
-	return *ret0, err
+	var out []interface{}
+	err := _ReverseRegistrarContract.contract.Call(opts, &out, "node", addr)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
 }
 
 // Node is a free data retrieval call binding the contract method 0xbffbe61c.
 //
-// Solidity: function node(addr address) constant returns(ret bytes32)
+// Solidity: function node(address addr) returns(bytes32 ret)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) Node(addr common.Address) ([32]byte, error) {
 	return _ReverseRegistrarContract.Contract.Node(&_ReverseRegistrarContract.CallOpts, addr)
 }
 
 // Node is a free data retrieval call binding the contract method 0xbffbe61c.
 //
-// Solidity: function node(addr address) constant returns(ret bytes32)
+// Solidity: function node(address addr) returns(bytes32 ret)
 func (_ReverseRegistrarContract *ReverseRegistrarContractCallerSession) Node(addr common.Address) ([32]byte, error) {
 	return _ReverseRegistrarContract.Contract.Node(&_ReverseRegistrarContract.CallOpts, addr)
 }
 
 // Claim is a paid mutator transaction binding the contract method 0x1e83409a.
 //
-// Solidity: function claim(owner address) returns(node bytes32)
+// Solidity: function claim(address owner) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactor) Claim(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.contract.Transact(opts, "claim", owner)
 }
 
 // Claim is a paid mutator transaction binding the contract method 0x1e83409a.
 //
-// Solidity: function claim(owner address) returns(node bytes32)
+// Solidity: function claim(address owner) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) Claim(owner common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.Claim(&_ReverseRegistrarContract.TransactOpts, owner)
 }
 
 // Claim is a paid mutator transaction binding the contract method 0x1e83409a.
 //
-// Solidity: function claim(owner address) returns(node bytes32)
+// Solidity: function claim(address owner) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactorSession) Claim(owner common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.Claim(&_ReverseRegistrarContract.TransactOpts, owner)
 }
 
 // ClaimWithResolver is a paid mutator transaction binding the contract method 0x0f5a5466.
 //
-// Solidity: function claimWithResolver(owner address, resolver address) returns(node bytes32)
+// Solidity: function claimWithResolver(address owner, address resolver) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactor) ClaimWithResolver(opts *bind.TransactOpts, owner common.Address, resolver common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.contract.Transact(opts, "claimWithResolver", owner, resolver)
 }
 
 // ClaimWithResolver is a paid mutator transaction binding the contract method 0x0f5a5466.
 //
-// Solidity: function claimWithResolver(owner address, resolver address) returns(node bytes32)
+// Solidity: function claimWithResolver(address owner, address resolver) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) ClaimWithResolver(owner common.Address, resolver common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.ClaimWithResolver(&_ReverseRegistrarContract.TransactOpts, owner, resolver)
 }
 
 // ClaimWithResolver is a paid mutator transaction binding the contract method 0x0f5a5466.
 //
-// Solidity: function claimWithResolver(owner address, resolver address) returns(node bytes32)
+// Solidity: function claimWithResolver(address owner, address resolver) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactorSession) ClaimWithResolver(owner common.Address, resolver common.Address) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.ClaimWithResolver(&_ReverseRegistrarContract.TransactOpts, owner, resolver)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0xc47f0027.
 //
-// Solidity: function setName(name string) returns(node bytes32)
+// Solidity: function setName(string name) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactor) SetName(opts *bind.TransactOpts, name string) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.contract.Transact(opts, "setName", name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0xc47f0027.
 //
-// Solidity: function setName(name string) returns(node bytes32)
+// Solidity: function setName(string name) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractSession) SetName(name string) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.SetName(&_ReverseRegistrarContract.TransactOpts, name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0xc47f0027.
 //
-// Solidity: function setName(name string) returns(node bytes32)
+// Solidity: function setName(string name) returns(bytes32 node)
 func (_ReverseRegistrarContract *ReverseRegistrarContractTransactorSession) SetName(name string) (*types.Transaction, error) {
 	return _ReverseRegistrarContract.Contract.SetName(&_ReverseRegistrarContract.TransactOpts, name)
 }
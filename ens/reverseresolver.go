@@ -108,3 +108,26 @@ func CreateReverseResolverSession(chainID *big.Int, wallet *accounts.Wallet, acc
 
 	return session
 }
+
+// CreateReverseResolverSessionWithSigner creates a session suitable for multiple calls using a signer
+func CreateReverseResolverSessionWithSigner(chainID *big.Int, signer etherutils.Signer, contract *reverseresolvercontract.ReverseResolver, gasPrice *big.Int) *reverseresolvercontract.ReverseResolverSession {
+	return &reverseresolvercontract.ReverseResolverSession{
+		Contract: contract,
+		CallOpts: bind.CallOpts{
+			Pending: true,
+		},
+		TransactOpts: bind.TransactOpts{
+			From:     signer.Address(),
+			Signer:   etherutils.BindSigner(chainID, signer),
+			GasPrice: gasPrice,
+		},
+	}
+}
+
+// CreateReverseResolverSessionWithTracker creates a session suitable for multiple calls using a signer,
+// recording every transaction it sends in the tracker with the given purpose
+func CreateReverseResolverSessionWithTracker(chainID *big.Int, signer etherutils.Signer, tracker *etherutils.TransactionTracker, purpose string, contract *reverseresolvercontract.ReverseResolver, gasPrice *big.Int) *reverseresolvercontract.ReverseResolverSession {
+	session := CreateReverseResolverSessionWithSigner(chainID, signer, contract, gasPrice)
+	session.TransactOpts.Signer = tracker.BindSigner(chainID, session.TransactOpts.Signer, purpose)
+	return session
+}
@@ -1,24 +1,52 @@
-// This file is an automatically generated Go binding. Do not modify as any
-// change will likely be lost upon the next re-generation!
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
 
 package reverseresolvercontract
 
 import (
+	"context"
+	"errors"
+	"math/big"
 	"strings"
+	"time"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 )
 
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+	_ = time.Tick
+	_ = context.Background
+)
+
+// ReverseResolverMetaData contains all meta data concerning the ReverseResolver contract.
+var ReverseResolverMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":true,\"inputs\":[],\"name\":\"ens\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"name\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"_name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[],\"payable\":false,\"type\":\"function\"},{\"inputs\":[{\"name\":\"ensAddr\",\"type\":\"address\"}],\"payable\":false,\"type\":\"constructor\"}]",
+}
+
 // ReverseResolverABI is the input ABI used to generate the binding from.
-const ReverseResolverABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"ens\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"name\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"node\",\"type\":\"bytes32\"},{\"name\":\"_name\",\"type\":\"string\"}],\"name\":\"setName\",\"outputs\":[],\"payable\":false,\"type\":\"function\"},{\"inputs\":[{\"name\":\"ensAddr\",\"type\":\"address\"}],\"payable\":false,\"type\":\"constructor\"}]"
+// Deprecated: Use ReverseResolverMetaData.ABI instead.
+var ReverseResolverABI = ReverseResolverMetaData.ABI
 
 // ReverseResolver is an auto generated Go binding around an Ethereum contract.
 type ReverseResolver struct {
 	ReverseResolverCaller     // Read-only binding to the contract
 	ReverseResolverTransactor // Write-only binding to the contract
+	ReverseResolverFilterer   // Log filterer for contract events
 }
 
 // ReverseResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
@@ -31,6 +59,11 @@ type ReverseResolverTransactor struct {
 	contract *bind.BoundContract // Generic contract wrapper for the low level calls
 }
 
+// ReverseResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ReverseResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
 // ReverseResolverSession is an auto generated Go binding around an Ethereum contract,
 // with pre-set call and transact options.
 type ReverseResolverSession struct {
@@ -70,16 +103,16 @@ type ReverseResolverTransactorRaw struct {
 
 // NewReverseResolver creates a new instance of ReverseResolver, bound to a specific deployed contract.
 func NewReverseResolver(address common.Address, backend bind.ContractBackend) (*ReverseResolver, error) {
-	contract, err := bindReverseResolver(address, backend, backend)
+	contract, err := bindReverseResolver(address, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
-	return &ReverseResolver{ReverseResolverCaller: ReverseResolverCaller{contract: contract}, ReverseResolverTransactor: ReverseResolverTransactor{contract: contract}}, nil
+	return &ReverseResolver{ReverseResolverCaller: ReverseResolverCaller{contract: contract}, ReverseResolverTransactor: ReverseResolverTransactor{contract: contract}, ReverseResolverFilterer: ReverseResolverFilterer{contract: contract}}, nil
 }
 
 // NewReverseResolverCaller creates a new read-only instance of ReverseResolver, bound to a specific deployed contract.
 func NewReverseResolverCaller(address common.Address, caller bind.ContractCaller) (*ReverseResolverCaller, error) {
-	contract, err := bindReverseResolver(address, caller, nil)
+	contract, err := bindReverseResolver(address, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,27 +121,36 @@ func NewReverseResolverCaller(address common.Address, caller bind.ContractCaller
 
 // NewReverseResolverTransactor creates a new write-only instance of ReverseResolver, bound to a specific deployed contract.
 func NewReverseResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*ReverseResolverTransactor, error) {
-	contract, err := bindReverseResolver(address, nil, transactor)
+	contract, err := bindReverseResolver(address, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &ReverseResolverTransactor{contract: contract}, nil
 }
 
+// NewReverseResolverFilterer creates a new log filterer instance of ReverseResolver, bound to a specific deployed contract.
+func NewReverseResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*ReverseResolverFilterer, error) {
+	contract, err := bindReverseResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ReverseResolverFilterer{contract: contract}, nil
+}
+
 // bindReverseResolver binds a generic wrapper to an already deployed contract.
-func bindReverseResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(ReverseResolverABI))
+func bindReverseResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ReverseResolverMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, nil), nil
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_ReverseResolver *ReverseResolverRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_ReverseResolver *ReverseResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _ReverseResolver.Contract.ReverseResolverCaller.contract.Call(opts, result, method, params...)
 }
 
@@ -127,7 +169,7 @@ func (_ReverseResolver *ReverseResolverRaw) Transact(opts *bind.TransactOpts, me
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (_ReverseResolver *ReverseResolverCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+func (_ReverseResolver *ReverseResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
 	return _ReverseResolver.Contract.contract.Call(opts, result, method, params...)
 }
 
@@ -144,73 +186,83 @@ func (_ReverseResolver *ReverseResolverTransactorRaw) Transact(opts *bind.Transa
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseResolver *ReverseResolverCaller) Ens(opts *bind.CallOpts) (common.Address, error) {
-	var (
-		ret0 = new(common.Address)
-	)
-	out := ret0
-	err := _ReverseResolver.contract.Call(opts, out, "ens")
-	return *ret0, err
+	var out []interface{}
+	err := _ReverseResolver.contract.Call(opts, &out, "ens")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
 }
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseResolver *ReverseResolverSession) Ens() (common.Address, error) {
 	return _ReverseResolver.Contract.Ens(&_ReverseResolver.CallOpts)
 }
 
 // Ens is a free data retrieval call binding the contract method 0x3f15457f.
 //
-// Solidity: function ens() constant returns(address)
+// Solidity: function ens() returns(address)
 func (_ReverseResolver *ReverseResolverCallerSession) Ens() (common.Address, error) {
 	return _ReverseResolver.Contract.Ens(&_ReverseResolver.CallOpts)
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name( bytes32) constant returns(string)
+// Solidity: function name(bytes32 ) returns(string)
 func (_ReverseResolver *ReverseResolverCaller) Name(opts *bind.CallOpts, arg0 [32]byte) (string, error) {
-	var (
-		ret0 = new(string)
-	)
-	out := ret0
-	err := _ReverseResolver.contract.Call(opts, out, "name", arg0)
-	return *ret0, err
+	var out []interface{}
+	err := _ReverseResolver.contract.Call(opts, &out, "name", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name( bytes32) constant returns(string)
+// Solidity: function name(bytes32 ) returns(string)
 func (_ReverseResolver *ReverseResolverSession) Name(arg0 [32]byte) (string, error) {
 	return _ReverseResolver.Contract.Name(&_ReverseResolver.CallOpts, arg0)
 }
 
 // Name is a free data retrieval call binding the contract method 0x691f3431.
 //
-// Solidity: function name( bytes32) constant returns(string)
+// Solidity: function name(bytes32 ) returns(string)
 func (_ReverseResolver *ReverseResolverCallerSession) Name(arg0 [32]byte) (string, error) {
 	return _ReverseResolver.Contract.Name(&_ReverseResolver.CallOpts, arg0)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, _name string) returns()
+// Solidity: function setName(bytes32 node, string _name) returns()
 func (_ReverseResolver *ReverseResolverTransactor) SetName(opts *bind.TransactOpts, node [32]byte, _name string) (*types.Transaction, error) {
 	return _ReverseResolver.contract.Transact(opts, "setName", node, _name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, _name string) returns()
+// Solidity: function setName(bytes32 node, string _name) returns()
 func (_ReverseResolver *ReverseResolverSession) SetName(node [32]byte, _name string) (*types.Transaction, error) {
 	return _ReverseResolver.Contract.SetName(&_ReverseResolver.TransactOpts, node, _name)
 }
 
 // SetName is a paid mutator transaction binding the contract method 0x77372213.
 //
-// Solidity: function setName(node bytes32, _name string) returns()
+// Solidity: function setName(bytes32 node, string _name) returns()
 func (_ReverseResolver *ReverseResolverTransactorSession) SetName(node [32]byte, _name string) (*types.Transaction, error) {
 	return _ReverseResolver.Contract.SetName(&_ReverseResolver.TransactOpts, node, _name)
 }
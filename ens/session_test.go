@@ -0,0 +1,103 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ens
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	etherutils "github.com/orinocopay/go-etherutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var testChainID = big.NewInt(1337)
+
+// newTestSessionSigner creates a session signer for a new keystore account
+func newTestSessionSigner(t *testing.T) *etherutils.SessionSigner {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err, "Failed to generate key")
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	signer, err := etherutils.NewSessionSigner(&account, "secret", 0)
+	assert.Nil(t, err, "Failed to create session signer")
+	t.Cleanup(signer.Lock)
+	return signer
+}
+
+// testSessionSigns checks that transact options sign transactions from the signer
+func testSessionSigns(t *testing.T, opts bind.TransactOpts, signer etherutils.Signer, nonce uint64) *types.Transaction {
+	assert.Equal(t, signer.Address(), opts.From, "Did not receive expected sender")
+	assert.Equal(t, big.NewInt(20000000000), opts.GasPrice, "Did not receive expected gas price")
+	tx := types.NewTransaction(nonce, common.HexToAddress("0x01"), big.NewInt(0), 50000, opts.GasPrice, nil)
+	signed, err := opts.Signer(opts.From, tx)
+	assert.Nil(t, err, "Failed to sign transaction")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to obtain sender")
+	assert.Equal(t, signer.Address(), sender, "Did not receive expected sender")
+	return signed
+}
+
+func TestCreateSessionsWithSigner(t *testing.T) {
+	signer := newTestSessionSigner(t)
+	gasPrice := big.NewInt(20000000000)
+
+	// Sessions share the unlocked key, so many operations can be signed
+	testSessionSigns(t, CreateRegistrySessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 0)
+	testSessionSigns(t, CreateRegistrarSessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 1)
+	testSessionSigns(t, CreateResolverSessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 2)
+	testSessionSigns(t, CreateDnsResolverSessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 3)
+	testSessionSigns(t, CreateReverseRegistrarSessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 4)
+	testSessionSigns(t, CreateReverseResolverSessionWithSigner(testChainID, signer, nil, gasPrice).TransactOpts, signer, 5)
+
+	// Signing stops when the session ends
+	session := CreateRegistrySessionWithSigner(testChainID, signer, nil, gasPrice)
+	signer.Lock()
+	_, err := session.TransactOpts.Signer(signer.Address(), types.NewTransaction(0, common.HexToAddress("0x01"), big.NewInt(0), 50000, gasPrice, nil))
+	assert.NotNil(t, err, "Signed transaction after session ended")
+}
+
+func TestCreateSessionsWithTracker(t *testing.T) {
+	signer := newTestSessionSigner(t)
+	gasPrice := big.NewInt(20000000000)
+	store, err := etherutils.NewFileTransactionStore(filepath.Join(t.TempDir(), "transactions.json"))
+	assert.Nil(t, err, "Failed to create store")
+	tracker := etherutils.NewTransactionTracker(store)
+
+	signed := []*types.Transaction{
+		testSessionSigns(t, CreateRegistrySessionWithTracker(testChainID, signer, tracker, "registry", nil, gasPrice).TransactOpts, signer, 0),
+		testSessionSigns(t, CreateRegistrarSessionWithTracker(testChainID, signer, tracker, "registrar", nil, gasPrice).TransactOpts, signer, 1),
+		testSessionSigns(t, CreateResolverSessionWithTracker(testChainID, signer, tracker, "resolver", nil, gasPrice).TransactOpts, signer, 2),
+		testSessionSigns(t, CreateDnsResolverSessionWithTracker(testChainID, signer, tracker, "DNS resolver", nil, gasPrice).TransactOpts, signer, 3),
+		testSessionSigns(t, CreateReverseRegistrarSessionWithTracker(testChainID, signer, tracker, "reverse registrar", nil, gasPrice).TransactOpts, signer, 4),
+		testSessionSigns(t, CreateReverseResolverSessionWithTracker(testChainID, signer, tracker, "reverse resolver", nil, gasPrice).TransactOpts, signer, 5),
+	}
+	purposes := []string{"registry", "registrar", "resolver", "DNS resolver", "reverse registrar", "reverse resolver"}
+
+	txs, err := tracker.Transactions()
+	assert.Nil(t, err, "Failed to obtain transactions")
+	assert.Equal(t, len(signed), len(txs), "Did not receive expected number of transactions")
+	for i := range txs {
+		assert.Equal(t, signed[i].Hash(), txs[i].Hash, "Did not receive expected hash")
+		assert.Equal(t, purposes[i], txs[i].Purpose, "Did not receive expected purpose")
+		assert.Equal(t, testChainID, txs[i].ChainID, "Did not receive expected chain ID")
+	}
+}
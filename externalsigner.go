@@ -0,0 +1,184 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ExternalSigner signs using an external signer that speaks the Clef JSON-RPC
+// protocol.  Keys never leave the external signer, which is free to ask its
+// operator to confirm each request
+type ExternalSigner struct {
+	client  *rpc.Client
+	address common.Address
+	timeout time.Duration
+}
+
+// externalTransactionArgs are the arguments to account_signTransaction
+type externalTransactionArgs struct {
+	From                 common.MixedcaseAddress  `json:"from"`
+	To                   *common.MixedcaseAddress `json:"to"`
+	Gas                  hexutil.Uint64           `json:"gas"`
+	GasPrice             *hexutil.Big             `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big             `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big             `json:"maxPriorityFeePerGas,omitempty"`
+	Value                hexutil.Big              `json:"value"`
+	Nonce                hexutil.Uint64           `json:"nonce"`
+	Data                 *hexutil.Bytes           `json:"data,omitempty"`
+	AccessList           *types.AccessList        `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big             `json:"chainId,omitempty"`
+}
+
+// externalTransactionResult is the result of account_signTransaction
+type externalTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// NewExternalSigner connects to an external signer at the given endpoint,
+// which can be an HTTP, websocket or IPC address.  Each request to the signer
+// is abandoned after the timeout; a timeout of 0 means no limit, which is
+// usually wanted when the signer asks a person to confirm requests
+func NewExternalSigner(endpoint string, address common.Address, timeout time.Duration) (*ExternalSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &ExternalSigner{
+		client:  client,
+		address: address,
+		timeout: timeout,
+	}, nil
+}
+
+// Close closes the connection to the external signer
+func (s *ExternalSigner) Close() {
+	s.client.Close()
+}
+
+// Address returns the address of the account
+func (s *ExternalSigner) Address() common.Address {
+	return s.address
+}
+
+// Accounts returns the accounts available from the external signer
+func (s *ExternalSigner) Accounts() ([]common.Address, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	var accounts []common.Address
+	if err := s.client.CallContext(ctx, &accounts, "account_list"); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// SignTx signs a transaction for the given chain
+func (s *ExternalSigner) SignTx(chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	txSigner, err := TransactionSigner(chainID, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := externalTransactionArgs{
+		From:    common.NewMixedcaseAddress(s.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+	if len(tx.Data()) > 0 {
+		data := hexutil.Bytes(tx.Data())
+		args.Data = &data
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, fmt.Errorf("external signer does not support transaction type %d", tx.Type())
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+	var result externalTransactionResult
+	if err := s.client.CallContext(ctx, &result, "account_signTransaction", args); err != nil {
+		return nil, err
+	}
+	if result.Tx == nil {
+		return nil, errors.New("external signer did not return a transaction")
+	}
+
+	// Ensure that the signer signed what we asked it to sign
+	if txSigner.Hash(result.Tx) != txSigner.Hash(tx) {
+		return nil, errors.New("external signer returned a different transaction")
+	}
+	sender, err := types.Sender(txSigner, result.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("external signer signed with %s rather than %s", sender.Hex(), s.address.Hex())
+	}
+	return result.Tx, nil
+}
+
+// SignHash is not supported, as Clef will not sign arbitrary hashes
+func (s *ExternalSigner) SignHash(hash []byte) ([]byte, error) {
+	return nil, errors.New("external signer does not sign raw hashes")
+}
+
+// SignTypedData signs EIP-712 typed data
+func (s *ExternalSigner) SignTypedData(data *apitypes.TypedData) ([]byte, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	var signature hexutil.Bytes
+	if err := s.client.CallContext(ctx, &signature, "account_signTypedData", common.NewMixedcaseAddress(s.address), data); err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("external signer returned signature of invalid length %d", len(signature))
+	}
+	return signature, nil
+}
+
+func (s *ExternalSigner) context() (context.Context, context.CancelFunc) {
+	if s.timeout == 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), s.timeout)
+}
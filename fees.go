@@ -0,0 +1,312 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeType selects the kind of fees that a fee oracle produces
+type FeeType int
+
+const (
+	// LegacyFees produce a single gas price
+	LegacyFees FeeType = iota
+	// DynamicFees produce an EIP-1559 tip and fee cap
+	DynamicFees
+)
+
+// Fees are the fee fields for a transaction.  Legacy fees have GasPrice set;
+// EIP-1559 fees have GasTipCap and GasFeeCap set
+type Fees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// IsDynamic returns true if the fees are for an EIP-1559 transaction
+func (f *Fees) IsDynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+// Apply sets the fees on transaction options, for example the TransactOpts
+// of a contract session
+func (f *Fees) Apply(opts *bind.TransactOpts) {
+	if f.IsDynamic() {
+		opts.GasPrice = nil
+		opts.GasTipCap = new(big.Int).Set(f.GasTipCap)
+		opts.GasFeeCap = new(big.Int).Set(f.GasFeeCap)
+	} else {
+		opts.GasPrice = new(big.Int).Set(f.GasPrice)
+		opts.GasTipCap = nil
+		opts.GasFeeCap = nil
+	}
+}
+
+// String returns a summary of the fees, e.g. "gas price 20 GWei"
+func (f *Fees) String() string {
+	if f.IsDynamic() {
+		return fmt.Sprintf("tip %s, fee cap %s", WeiToString(f.GasTipCap, true), WeiToString(f.GasFeeCap, true))
+	}
+	return fmt.Sprintf("gas price %s", WeiToString(f.GasPrice, true))
+}
+
+// FeeOracle decides the fees for a transaction
+type FeeOracle interface {
+	Fees(ctx context.Context) (*Fees, error)
+}
+
+// FeeBackend is the part of ethclient.Client needed by the fee oracles
+type FeeBackend interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// errNoBaseFee is returned when EIP-1559 fees are requested from a chain
+// without a base fee
+var errNoBaseFee = errors.New("Chain does not have a base fee")
+
+// dynamicFees creates EIP-1559 fees from a base fee and tip.  The fee cap
+// allows for the base fee to double before the transaction is mined
+func dynamicFees(baseFee *big.Int, tip *big.Int) *Fees {
+	feeCap := new(big.Int).Lsh(baseFee, 1)
+	feeCap.Add(feeCap, tip)
+	return &Fees{
+		GasTipCap: new(big.Int).Set(tip),
+		GasFeeCap: feeCap,
+	}
+}
+
+// latestBaseFee obtains the base fee of the latest block
+func latestBaseFee(ctx context.Context, backend FeeBackend) (*big.Int, error) {
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		return nil, errNoBaseFee
+	}
+	return head.BaseFee, nil
+}
+
+type nodeFeeOracle struct {
+	backend FeeBackend
+	feeType FeeType
+}
+
+// NewNodeFeeOracle creates a fee oracle that uses the fees suggested by the
+// node
+func NewNodeFeeOracle(backend FeeBackend, feeType FeeType) FeeOracle {
+	return &nodeFeeOracle{backend: backend, feeType: feeType}
+}
+
+func (o *nodeFeeOracle) Fees(ctx context.Context) (*Fees, error) {
+	if o.feeType == LegacyFees {
+		gasPrice, err := o.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Fees{GasPrice: gasPrice}, nil
+	}
+	tip, err := o.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseFee, err := latestBaseFee(ctx, o.backend)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicFees(baseFee, tip), nil
+}
+
+type feeHistoryOracle struct {
+	backend    FeeBackend
+	feeType    FeeType
+	blocks     uint64
+	percentile float64
+}
+
+// NewFeeHistoryOracle creates a fee oracle that uses eth_feeHistory to find
+// the tip paid at the given percentile (0 to 100) of each of the most recent
+// blocks, and takes the median.  Legacy gas prices are the pending base fee
+// plus that tip
+func NewFeeHistoryOracle(backend FeeBackend, feeType FeeType, blocks uint64, percentile float64) (FeeOracle, error) {
+	if blocks == 0 {
+		return nil, errors.New("Number of blocks must be at least 1")
+	}
+	if percentile < 0 || percentile > 100 {
+		return nil, errors.New("Percentile must be between 0 and 100")
+	}
+	return &feeHistoryOracle{
+		backend:    backend,
+		feeType:    feeType,
+		blocks:     blocks,
+		percentile: percentile,
+	}, nil
+}
+
+func (o *feeHistoryOracle) Fees(ctx context.Context) (*Fees, error) {
+	history, err := o.backend.FeeHistory(ctx, o.blocks, nil, []float64{o.percentile})
+	if err != nil {
+		return nil, err
+	}
+	if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil {
+		return nil, errNoBaseFee
+	}
+	// The final base fee is that of the pending block
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for _, reward := range history.Reward {
+		if len(reward) > 0 && reward[0] != nil {
+			rewards = append(rewards, reward[0])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil, errors.New("Fee history has no rewards")
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tip := rewards[len(rewards)/2]
+
+	if o.feeType == LegacyFees {
+		return &Fees{GasPrice: new(big.Int).Add(baseFee, tip)}, nil
+	}
+	return dynamicFees(baseFee, tip), nil
+}
+
+type fixedFeeOracle struct {
+	fees *Fees
+}
+
+// NewFixedFeeOracle creates a fee oracle that always returns the same
+// legacy gas price.  The gas price can be any value accepted by StringToWei,
+// e.g. "20 gwei"
+func NewFixedFeeOracle(gasPrice string) (FeeOracle, error) {
+	price, err := StringToWei(gasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid gas price: %v", err)
+	}
+	return &fixedFeeOracle{fees: &Fees{GasPrice: price}}, nil
+}
+
+// NewFixedDynamicFeeOracle creates a fee oracle that always returns the same
+// EIP-1559 tip and fee cap.  The values can be any accepted by StringToWei,
+// e.g. "2 gwei" and "200 gwei"
+func NewFixedDynamicFeeOracle(tip string, feeCap string) (FeeOracle, error) {
+	priority, err := StringToWei(tip)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid tip: %v", err)
+	}
+	maxFee, err := StringToWei(feeCap)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid fee cap: %v", err)
+	}
+	if priority.Cmp(maxFee) > 0 {
+		return nil, errors.New("Tip is higher than fee cap")
+	}
+	return &fixedFeeOracle{fees: &Fees{GasTipCap: priority, GasFeeCap: maxFee}}, nil
+}
+
+func (o *fixedFeeOracle) Fees(ctx context.Context) (*Fees, error) {
+	if o.fees.IsDynamic() {
+		return &Fees{GasTipCap: new(big.Int).Set(o.fees.GasTipCap), GasFeeCap: new(big.Int).Set(o.fees.GasFeeCap)}, nil
+	}
+	return &Fees{GasPrice: new(big.Int).Set(o.fees.GasPrice)}, nil
+}
+
+type baseFeeMultiplierOracle struct {
+	backend    FeeBackend
+	feeType    FeeType
+	multiplier *big.Rat
+	tip        *big.Int
+}
+
+// NewBaseFeeMultiplierOracle creates a fee oracle that sets the fee cap, or
+// legacy gas price, to a multiple of the latest base fee plus a fixed tip.
+// The tip can be any value accepted by StringToWei, e.g. "1.5 gwei"
+func NewBaseFeeMultiplierOracle(backend FeeBackend, feeType FeeType, multiplier float64, tip string) (FeeOracle, error) {
+	if multiplier < 1 || math.IsInf(multiplier, 0) || math.IsNaN(multiplier) {
+		return nil, errors.New("Multiplier must be at least 1")
+	}
+	priority, err := StringToWei(tip)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid tip: %v", err)
+	}
+	return &baseFeeMultiplierOracle{
+		backend:    backend,
+		feeType:    feeType,
+		multiplier: new(big.Rat).SetFloat64(multiplier),
+		tip:        priority,
+	}, nil
+}
+
+func (o *baseFeeMultiplierOracle) Fees(ctx context.Context) (*Fees, error) {
+	baseFee, err := latestBaseFee(ctx, o.backend)
+	if err != nil {
+		return nil, err
+	}
+	scaled := new(big.Rat).Mul(new(big.Rat).SetInt(baseFee), o.multiplier)
+	feeCap := divRound(scaled.Num(), scaled.Denom(), RoundCeiling)
+	feeCap.Add(feeCap, o.tip)
+	if o.feeType == LegacyFees {
+		return &Fees{GasPrice: feeCap}, nil
+	}
+	return &Fees{GasTipCap: new(big.Int).Set(o.tip), GasFeeCap: feeCap}, nil
+}
+
+type cappedFeeOracle struct {
+	oracle FeeOracle
+	maxFee *big.Int
+}
+
+// NewCappedFeeOracle wraps a fee oracle so that the gas price, or EIP-1559
+// fee cap, it returns is never more than maxFee.  The maximum can be any
+// value accepted by StringToWei, e.g. "200 gwei"
+func NewCappedFeeOracle(oracle FeeOracle, maxFee string) (FeeOracle, error) {
+	limit, err := StringToWei(maxFee)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid maximum fee: %v", err)
+	}
+	return &cappedFeeOracle{oracle: oracle, maxFee: limit}, nil
+}
+
+func (o *cappedFeeOracle) Fees(ctx context.Context) (*Fees, error) {
+	fees, err := o.oracle.Fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if fees.IsDynamic() {
+		if fees.GasFeeCap.Cmp(o.maxFee) > 0 {
+			fees.GasFeeCap = new(big.Int).Set(o.maxFee)
+		}
+		if fees.GasTipCap.Cmp(fees.GasFeeCap) > 0 {
+			fees.GasTipCap = new(big.Int).Set(fees.GasFeeCap)
+		}
+	} else if fees.GasPrice.Cmp(o.maxFee) > 0 {
+		fees.GasPrice = new(big.Int).Set(o.maxFee)
+	}
+	return fees, nil
+}
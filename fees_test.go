@@ -0,0 +1,174 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gwei = 1000000000
+
+// testFeeBackend returns fixed fee information
+type testFeeBackend struct {
+	gasPrice *big.Int
+	tip      *big.Int
+	baseFee  *big.Int
+	history  *ethereum.FeeHistory
+}
+
+func (b *testFeeBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return b.gasPrice, nil
+}
+
+func (b *testFeeBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return b.tip, nil
+}
+
+func (b *testFeeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: b.baseFee}, nil
+}
+
+func (b *testFeeBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return b.history, nil
+}
+
+func newTestFeeBackend() *testFeeBackend {
+	return &testFeeBackend{
+		gasPrice: big.NewInt(25 * gwei),
+		tip:      big.NewInt(2 * gwei),
+		baseFee:  big.NewInt(30 * gwei),
+		history: &ethereum.FeeHistory{
+			OldestBlock: big.NewInt(98),
+			Reward:      [][]*big.Int{{big.NewInt(3 * gwei)}, {big.NewInt(1 * gwei)}, {big.NewInt(2 * gwei)}},
+			BaseFee:     []*big.Int{big.NewInt(28 * gwei), big.NewInt(29 * gwei), big.NewInt(30 * gwei), big.NewInt(31 * gwei)},
+		},
+	}
+}
+
+func TestNodeFeeOracle(t *testing.T) {
+	backend := newTestFeeBackend()
+	fees, err := NewNodeFeeOracle(backend, LegacyFees).Fees(context.Background())
+	assert.Nil(t, err, "Failed to obtain fees")
+	assert.Equal(t, big.NewInt(25*gwei), fees.GasPrice, "Did not receive expected gas price")
+	assert.False(t, fees.IsDynamic(), "Received dynamic fees")
+
+	fees, err = NewNodeFeeOracle(backend, DynamicFees).Fees(context.Background())
+	assert.Nil(t, err, "Failed to obtain fees")
+	assert.Equal(t, big.NewInt(2*gwei), fees.GasTipCap, "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(62*gwei), fees.GasFeeCap, "Did not receive expected fee cap")
+	assert.Equal(t, "tip 2 GWei, fee cap 62 GWei", fees.String(), "Did not receive expected summary")
+
+	// Chain without a base fee
+	backend.baseFee = nil
+	_, err = NewNodeFeeOracle(backend, DynamicFees).Fees(context.Background())
+	assert.NotNil(t, err, "Did not receive error")
+}
+
+func TestFeeHistoryOracle(t *testing.T) {
+	backend := newTestFeeBackend()
+	oracle, err := NewFeeHistoryOracle(backend, DynamicFees, 3, 50)
+	assert.Nil(t, err, "Failed to create oracle")
+	fees, err := oracle.Fees(context.Background())
+	assert.Nil(t, err, "Failed to obtain fees")
+	assert.Equal(t, big.NewInt(2*gwei), fees.GasTipCap, "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(64*gwei), fees.GasFeeCap, "Did not receive expected fee cap")
+
+	oracle, _ = NewFeeHistoryOracle(backend, LegacyFees, 3, 50)
+	fees, err = oracle.Fees(context.Background())
+	assert.Nil(t, err, "Failed to obtain fees")
+	assert.Equal(t, big.NewInt(33*gwei), fees.GasPrice, "Did not receive expected gas price")
+
+	_, err = NewFeeHistoryOracle(backend, DynamicFees, 0, 50)
+	assert.NotNil(t, err, "Did not receive error")
+	_, err = NewFeeHistoryOracle(backend, DynamicFees, 3, 101)
+	assert.NotNil(t, err, "Did not receive error")
+}
+
+func TestFixedFeeOracle(t *testing.T) {
+	oracle, err := NewFixedFeeOracle("20 gwei")
+	assert.Nil(t, err, "Failed to create oracle")
+	fees, _ := oracle.Fees(context.Background())
+	assert.Equal(t, "gas price 20 GWei", fees.String(), "Did not receive expected summary")
+
+	// Changing the returned fees does not change the oracle
+	fees.GasPrice.SetInt64(1)
+	fees, _ = oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(20*gwei), fees.GasPrice, "Did not receive expected gas price")
+
+	oracle, err = NewFixedDynamicFeeOracle("2 gwei", "200 gwei")
+	assert.Nil(t, err, "Failed to create oracle")
+	fees, _ = oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(2*gwei), fees.GasTipCap, "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(200*gwei), fees.GasFeeCap, "Did not receive expected fee cap")
+
+	_, err = NewFixedDynamicFeeOracle("3 gwei", "2 gwei")
+	assert.NotNil(t, err, "Did not receive error")
+	_, err = NewFixedFeeOracle("twenty gwei")
+	assert.NotNil(t, err, "Did not receive error")
+}
+
+func TestBaseFeeMultiplierOracle(t *testing.T) {
+	backend := newTestFeeBackend()
+	oracle, err := NewBaseFeeMultiplierOracle(backend, DynamicFees, 1.5, "1 gwei")
+	assert.Nil(t, err, "Failed to create oracle")
+	fees, err := oracle.Fees(context.Background())
+	assert.Nil(t, err, "Failed to obtain fees")
+	assert.Equal(t, big.NewInt(1*gwei), fees.GasTipCap, "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(46*gwei), fees.GasFeeCap, "Did not receive expected fee cap")
+
+	oracle, _ = NewBaseFeeMultiplierOracle(backend, LegacyFees, 2, "1 gwei")
+	fees, _ = oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(61*gwei), fees.GasPrice, "Did not receive expected gas price")
+
+	_, err = NewBaseFeeMultiplierOracle(backend, DynamicFees, 0.5, "1 gwei")
+	assert.NotNil(t, err, "Did not receive error")
+}
+
+func TestCappedFeeOracle(t *testing.T) {
+	backend := newTestFeeBackend()
+	oracle, err := NewCappedFeeOracle(NewNodeFeeOracle(backend, DynamicFees), "50 gwei")
+	assert.Nil(t, err, "Failed to create oracle")
+	fees, _ := oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(2*gwei), fees.GasTipCap, "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(50*gwei), fees.GasFeeCap, "Did not receive expected fee cap")
+
+	oracle, _ = NewCappedFeeOracle(NewNodeFeeOracle(backend, LegacyFees), "20 gwei")
+	fees, _ = oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(20*gwei), fees.GasPrice, "Did not receive expected gas price")
+
+	// Fees under the cap are unchanged
+	oracle, _ = NewCappedFeeOracle(NewNodeFeeOracle(backend, LegacyFees), "200 gwei")
+	fees, _ = oracle.Fees(context.Background())
+	assert.Equal(t, big.NewInt(25*gwei), fees.GasPrice, "Did not receive expected gas price")
+}
+
+func TestFeesApply(t *testing.T) {
+	opts := &bind.TransactOpts{GasPrice: big.NewInt(1)}
+	fees := &Fees{GasTipCap: big.NewInt(2 * gwei), GasFeeCap: big.NewInt(100 * gwei)}
+	fees.Apply(opts)
+	assert.Nil(t, opts.GasPrice, "Gas price not cleared")
+	assert.Equal(t, big.NewInt(100*gwei), opts.GasFeeCap, "Did not receive expected fee cap")
+
+	(&Fees{GasPrice: big.NewInt(20 * gwei)}).Apply(opts)
+	assert.Equal(t, big.NewInt(20*gwei), opts.GasPrice, "Did not receive expected gas price")
+	assert.Nil(t, opts.GasFeeCap, "Fee cap not cleared")
+}
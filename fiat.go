@@ -0,0 +1,177 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// PriceSource provides the price of Ether in fiat currencies
+type PriceSource interface {
+	// Price returns the price of one Ether in the given currency, e.g. "USD"
+	Price(currency string) (*Amount, error)
+}
+
+// Number of decimal places for currencies that do not use two
+var currencyDecimals = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"IDR": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// CurrencyDecimals returns the number of decimal places used by a currency
+func CurrencyDecimals(currency string) int {
+	if decimals, exists := currencyDecimals[strings.ToUpper(currency)]; exists {
+		return decimals
+	}
+	return 2
+}
+
+// FiatConverter converts between Wei and fiat currencies using exact
+// arithmetic
+type FiatConverter struct {
+	source PriceSource
+}
+
+// NewFiatConverter creates a converter using the given price source
+func NewFiatConverter(source PriceSource) *FiatConverter {
+	return &FiatConverter{
+		source: source,
+	}
+}
+
+// WeiToFiat converts a number of Wei to an amount of the given currency.
+// The result has the currency's usual number of decimal places and is
+// rounded using the supplied mode
+func (c *FiatConverter) WeiToFiat(wei *big.Int, currency string, mode RoundingMode) (*Amount, error) {
+	price, err := c.price(currency)
+	if err != nil {
+		return nil, err
+	}
+	decimals := CurrencyDecimals(currency)
+
+	// fiat = wei * price / 10^18, scaled to the currency's decimals
+	value := new(big.Int).Mul(wei, price.Value)
+	value.Mul(value, pow10(decimals))
+	value = divRound(value, pow10(EtherDecimals+price.Decimals), mode)
+	return &Amount{Value: value, Decimals: decimals, Symbol: strings.ToUpper(currency)}, nil
+}
+
+// FiatToWei converts an amount of a currency to a number of Wei, for example
+// to find out how much Wei is worth 20 USD.  The currency is taken from the
+// amount's symbol and the result is rounded using the supplied mode
+func (c *FiatConverter) FiatToWei(amount *Amount, mode RoundingMode) (*big.Int, error) {
+	if amount.Symbol == "" {
+		return nil, errors.New("Amount has no currency")
+	}
+	price, err := c.price(amount.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if price.Value.Sign() == 0 {
+		return nil, fmt.Errorf("Price of %s is zero", amount.Symbol)
+	}
+
+	// wei = fiat * 10^18 / price
+	value := new(big.Int).Mul(amount.Value, pow10(EtherDecimals+price.Decimals))
+	den := new(big.Int).Mul(price.Value, pow10(amount.Decimals))
+	return divRound(value, den, mode), nil
+}
+
+// FiatStringToWei parses a fiat amount such as "20 USD" or "20" for the given
+// currency and converts it to a number of Wei
+func (c *FiatConverter) FiatStringToWei(input string, currency string, mode RoundingMode) (*big.Int, error) {
+	amount, err := ParseAmount(input, CurrencyDecimals(currency), strings.ToUpper(currency))
+	if err != nil {
+		return nil, err
+	}
+	return c.FiatToWei(amount, mode)
+}
+
+// WeiToFiatString converts a number of Wei to a formatted amount of the given
+// currency, e.g. "12.50 USD"
+func (c *FiatConverter) WeiToFiatString(wei *big.Int, currency string, mode RoundingMode) (string, error) {
+	amount, err := c.WeiToFiat(wei, currency, mode)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", amount.FixedText(), amount.Symbol), nil
+}
+
+func (c *FiatConverter) price(currency string) (*Amount, error) {
+	price, err := c.source.Price(strings.ToUpper(currency))
+	if err != nil {
+		return nil, err
+	}
+	if price.Value.Sign() < 0 {
+		return nil, fmt.Errorf("Price of %s is negative", currency)
+	}
+	return price, nil
+}
+
+// MemoryPriceSource is a price source that holds prices in memory, for
+// example for tests or for prices obtained elsewhere
+type MemoryPriceSource struct {
+	mutex  sync.RWMutex
+	prices map[string]*Amount
+}
+
+// NewMemoryPriceSource creates an empty in-memory price source
+func NewMemoryPriceSource() *MemoryPriceSource {
+	return &MemoryPriceSource{
+		prices: make(map[string]*Amount),
+	}
+}
+
+// SetPrice sets the price of one Ether in a currency, e.g. "3456.78"
+func (s *MemoryPriceSource) SetPrice(currency string, price string) error {
+	currency = strings.ToUpper(currency)
+	number := strings.TrimSpace(price)
+	decimals := 0
+	if pos := strings.IndexByte(number, '.'); pos != -1 {
+		decimals = len(number) - pos - 1
+	}
+	amount, err := ParseAmount(number, decimals, currency)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	s.prices[currency] = amount
+	s.mutex.Unlock()
+	return nil
+}
+
+// Price returns the price of one Ether in the given currency
+func (s *MemoryPriceSource) Price(currency string) (*Amount, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	price, exists := s.prices[strings.ToUpper(currency)]
+	if !exists {
+		return nil, fmt.Errorf("No price for %s", currency)
+	}
+	return price, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFiatConverter() *FiatConverter {
+	source := NewMemoryPriceSource()
+	source.SetPrice("USD", "2000.00")
+	source.SetPrice("eur", "1850.5")
+	source.SetPrice("JPY", "300000")
+	return NewFiatConverter(source)
+}
+
+func TestWeiToFiat(t *testing.T) {
+	converter := testFiatConverter()
+	wei, _ := StringToWei("1.5 ether")
+	result, err := converter.WeiToFiat(wei, "usd", RoundHalfUp)
+	assert.Nil(t, err, "Failed to convert Wei to fiat")
+	assert.Equal(t, "3000 USD", result.String(), "Did not receive expected result")
+	assert.Equal(t, 2, result.Decimals, "Did not receive expected decimals")
+}
+
+func TestWeiToFiatRounding(t *testing.T) {
+	converter := testFiatConverter()
+	// 0.0001234 ether at 1850.5 EUR is 0.2283517 EUR
+	wei, _ := StringToWei("0.0001234 ether")
+	result, err := converter.WeiToFiat(wei, "EUR", RoundDown)
+	assert.Nil(t, err, "Failed to convert Wei to fiat")
+	assert.Equal(t, "0.22", result.FixedText(), "Did not receive expected result")
+	result, err = converter.WeiToFiat(wei, "EUR", RoundUp)
+	assert.Nil(t, err, "Failed to convert Wei to fiat")
+	assert.Equal(t, "0.23", result.FixedText(), "Did not receive expected result")
+}
+
+func TestWeiToFiatZeroDecimalCurrency(t *testing.T) {
+	converter := testFiatConverter()
+	wei, _ := StringToWei("0.00123 ether")
+	result, err := converter.WeiToFiatString(wei, "JPY", RoundHalfEven)
+	assert.Nil(t, err, "Failed to convert Wei to fiat")
+	assert.Equal(t, "369 JPY", result, "Did not receive expected result")
+}
+
+func TestWeiToFiatString(t *testing.T) {
+	converter := testFiatConverter()
+	wei, _ := StringToWei("0.25 ether")
+	result, err := converter.WeiToFiatString(wei, "USD", RoundHalfUp)
+	assert.Nil(t, err, "Failed to convert Wei to fiat")
+	assert.Equal(t, "500.00 USD", result, "Did not receive expected result")
+}
+
+func TestFiatToWei(t *testing.T) {
+	converter := testFiatConverter()
+	result, err := converter.FiatStringToWei("20 USD", "USD", RoundDown)
+	assert.Nil(t, err, "Failed to convert fiat to Wei")
+	expected, _ := StringToWei("0.01 ether")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestFiatToWeiRounding(t *testing.T) {
+	converter := testFiatConverter()
+	amount, _ := ParseAmount("1", 2, "EUR")
+	down, err := converter.FiatToWei(amount, RoundDown)
+	assert.Nil(t, err, "Failed to convert fiat to Wei")
+	up, err := converter.FiatToWei(amount, RoundUp)
+	assert.Nil(t, err, "Failed to convert fiat to Wei")
+	assert.Equal(t, big.NewInt(1), new(big.Int).Sub(up, down), "Did not receive expected result")
+}
+
+func TestFiatUnknownCurrency(t *testing.T) {
+	converter := testFiatConverter()
+	_, err := converter.WeiToFiat(big.NewInt(1), "GBP", RoundDown)
+	assert.NotNil(t, err, "Converted to unknown currency")
+	_, err = converter.FiatToWei(NewAmount(big.NewInt(1), 2, ""), RoundDown)
+	assert.NotNil(t, err, "Converted amount without currency")
+}
+
+func TestFiatZeroPrice(t *testing.T) {
+	source := NewMemoryPriceSource()
+	source.SetPrice("USD", "0")
+	converter := NewFiatConverter(source)
+	_, err := converter.FiatStringToWei("20", "USD", RoundDown)
+	assert.NotNil(t, err, "Converted with zero price")
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// WeiFormatter formats numbers of Wei with more control than WeiToString.
+// Note that the zero value shows no fractional digits; use NewWeiFormatter()
+// to obtain a formatter that shows all of them, as WeiToString does
+type WeiFormatter struct {
+	// Unit is the unit in which to display values, e.g. "ether" or "gwei",
+	// looked up in DefaultUnits.  If this is empty then the unit is chosen as
+	// per WeiToString
+	Unit string
+	// Standard restricts the automatically chosen unit to (KMG)Wei or Ether,
+	// as per WeiToString.  It has no effect if Unit is set
+	Standard bool
+	// MinDecimals is the minimum number of fractional digits to show; the
+	// fractional part is padded with zeros to this length
+	MinDecimals int
+	// MaxDecimals is the maximum number of fractional digits to show.  If this
+	// is negative there is no maximum
+	MaxDecimals int
+	// Rounding is used when digits are dropped due to MaxDecimals; the default
+	// of RoundDown truncates
+	Rounding RoundingMode
+	// Grouping, if set, separates thousands in the integer part, e.g. ','
+	Grouping rune
+	// Decimal is the decimal separator; if unset a period is used
+	Decimal rune
+	// NamedUnits shows named units (e.g. "Finney") rather than metric units
+	// (e.g. "Milliether")
+	NamedUnits bool
+	// NoUnit shows the number alone, for example for CSV exports
+	NoUnit bool
+	// Parentheses encloses negative values in parentheses rather than
+	// preceding them with a '-' sign, e.g. "(0.5 Ether)"
+	Parentheses bool
+}
+
+// NewWeiFormatter creates a formatter that chooses units and shows all
+// fractional digits as WeiToString does.  Unlike WeiToString it always shows
+// a unit, so zero is "0 Wei" rather than "0"
+func NewWeiFormatter() *WeiFormatter {
+	return &WeiFormatter{
+		MaxDecimals: -1,
+	}
+}
+
+// Format turns a number of Wei in to a string
+func (f *WeiFormatter) Format(input *big.Int) (string, error) {
+	var decimals int
+	var name string
+	var namedName string
+	if f.Unit == "" {
+		unit := weiUnit(new(big.Int).Abs(input), f.Standard)
+		if unit >= len(metricUnits) {
+			return "", fmt.Errorf("Value too large to format")
+		}
+		decimals = unit * 3
+		name = metricUnits[unit]
+		namedName = namedUnits[unit]
+	} else {
+		unit, err := DefaultUnits.Lookup(f.Unit)
+		if err != nil {
+			return "", err
+		}
+		decimals = unit.Decimals
+		name = unit.Name
+		namedName = unit.Name
+		for i := range metricUnits {
+			if metricUnits[i] == unit.Name {
+				namedName = namedUnits[i]
+			}
+		}
+	}
+
+	amount := NewAmount(input, decimals, "")
+	if f.MaxDecimals >= 0 && f.MaxDecimals < amount.Decimals {
+		amount = amount.Rescale(f.MaxDecimals, f.Rounding)
+	}
+
+	// Split the value so that we can pad and group its parts
+	integer := strings.TrimPrefix(amount.Text(), "-")
+	fraction := ""
+	if pos := strings.IndexByte(integer, '.'); pos != -1 {
+		fraction = integer[pos+1:]
+		integer = integer[:pos]
+	}
+	minDecimals := f.MinDecimals
+	if f.MaxDecimals >= 0 && minDecimals > f.MaxDecimals {
+		minDecimals = f.MaxDecimals
+	}
+	if len(fraction) < minDecimals {
+		fraction = fraction + strings.Repeat("0", minDecimals-len(fraction))
+	}
+	if f.Grouping != 0 {
+		integer = groupDigits(integer, f.Grouping)
+	}
+
+	var output strings.Builder
+	negative := amount.Sign() < 0
+	if negative {
+		if f.Parentheses {
+			output.WriteByte('(')
+		} else {
+			output.WriteByte('-')
+		}
+	}
+	output.WriteString(integer)
+	if fraction != "" {
+		if f.Decimal == 0 {
+			output.WriteByte('.')
+		} else {
+			output.WriteRune(f.Decimal)
+		}
+		output.WriteString(fraction)
+	}
+	if !f.NoUnit {
+		output.WriteByte(' ')
+		if f.NamedUnits {
+			output.WriteString(namedName)
+		} else {
+			output.WriteString(name)
+		}
+	}
+	if negative && f.Parentheses {
+		output.WriteByte(')')
+	}
+	return output.String(), nil
+}
+
+// groupDigits separates a string of digits in to groups of three
+func groupDigits(digits string, separator rune) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var output strings.Builder
+	first := len(digits) % 3
+	if first == 0 {
+		first = 3
+	}
+	output.WriteString(digits[:first])
+	for i := first; i < len(digits); i += 3 {
+		output.WriteRune(separator)
+		output.WriteString(digits[i : i+3])
+	}
+	return output.String()
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeiFormatterDefaultsMatchWeiToString(t *testing.T) {
+	formatter := NewWeiFormatter()
+	for _, value := range []string{"1", "2034", "1234567890", "1000000000000000001", "1000000000000000000000000000000"} {
+		wei, _ := new(big.Int).SetString(value, 10)
+		result, err := formatter.Format(wei)
+		assert.Nil(t, err, "Failed to format value")
+		assert.Equal(t, WeiToString(wei, false), result, "Did not receive expected result")
+	}
+
+	// Zero has a unit
+	result, err := formatter.Format(big.NewInt(0))
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "0 Wei", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterFixedUnit(t *testing.T) {
+	formatter := NewWeiFormatter()
+	formatter.Unit = "ether"
+	result, err := formatter.Format(big.NewInt(1234567890))
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "0.00000000123456789 Ether", result, "Did not receive expected result")
+
+	formatter.Unit = "gwei"
+	wei, _ := new(big.Int).SetString("1500000000000000000", 10)
+	result, err = formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1500000000 GWei", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterUnknownUnit(t *testing.T) {
+	formatter := NewWeiFormatter()
+	formatter.Unit = "foo"
+	_, err := formatter.Format(big.NewInt(1))
+	assert.NotNil(t, err, "Formatted value with unknown unit")
+}
+
+func TestWeiFormatterMaxDecimals(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1234567890000000000", 10)
+	formatter := &WeiFormatter{Unit: "ether", MaxDecimals: 4}
+	result, err := formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1.2345 Ether", result, "Did not receive expected result")
+
+	formatter.Rounding = RoundHalfUp
+	result, err = formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1.2346 Ether", result, "Did not receive expected result")
+
+	formatter.MaxDecimals = 0
+	result, err = formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1 Ether", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterMinDecimals(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1500000000000000000", 10)
+	formatter := &WeiFormatter{Unit: "ether", MinDecimals: 4, MaxDecimals: 6}
+	result, err := formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1.5000 Ether", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterGrouping(t *testing.T) {
+	wei, _ := new(big.Int).SetString("-1234567500000000000000000", 10)
+	formatter := &WeiFormatter{Unit: "ether", MaxDecimals: 2, Grouping: '.', Decimal: ','}
+	result, err := formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "-1.234.567,5 Ether", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterNamedUnits(t *testing.T) {
+	formatter := NewWeiFormatter()
+	formatter.NamedUnits = true
+	result, err := formatter.Format(big.NewInt(1000000000000000))
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "1 Finney", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterNoUnit(t *testing.T) {
+	wei, _ := new(big.Int).SetString("2500000000000000000", 10)
+	formatter := &WeiFormatter{Unit: "ether", MaxDecimals: -1, NoUnit: true}
+	result, err := formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "2.5", result, "Did not receive expected result")
+}
+
+func TestWeiFormatterNegative(t *testing.T) {
+	wei, _ := new(big.Int).SetString("-500000000000000000", 10)
+	formatter := NewWeiFormatter()
+	result, err := formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "-500 Milliether", result, "Did not receive expected result")
+
+	formatter.Unit = "ether"
+	formatter.Parentheses = true
+	result, err = formatter.Format(wei)
+	assert.Nil(t, err, "Failed to format value")
+	assert.Equal(t, "(0.5 Ether)", result, "Did not receive expected result")
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// GasCost is the cost of a transaction, in Wei.  For transactions with a
+// fixed gas price the expected and maximum costs are the same
+type GasCost struct {
+	// Expected is the cost expected to be paid
+	Expected *big.Int
+	// Maximum is the most that can be paid
+	Maximum *big.Int
+}
+
+// AddValue returns the cost including the value sent with the transaction,
+// which gives the total that will leave the sending account.  The value can
+// be anything accepted by StringToWei, e.g. "1.5 ether"
+func (c *GasCost) AddValue(value string) (*GasCost, error) {
+	amount, err := StringToWei(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value: %v", err)
+	}
+	return &GasCost{
+		Expected: new(big.Int).Add(c.Expected, amount),
+		Maximum:  new(big.Int).Add(c.Maximum, amount),
+	}, nil
+}
+
+// String returns a summary of the cost, e.g. "this will cost 0.00042 Ether"
+func (c *GasCost) String() string {
+	if c.Expected.Cmp(c.Maximum) == 0 {
+		return fmt.Sprintf("this will cost %s", WeiToString(c.Expected, true))
+	}
+	return fmt.Sprintf("this will cost %s (maximum %s)", WeiToString(c.Expected, true), WeiToString(c.Maximum, true))
+}
+
+// LegacyGasCost calculates the cost of a transaction with a gas price.
+// The gas price can be any value accepted by StringToWei, e.g. "20 gwei"
+func LegacyGasCost(gasLimit uint64, gasPrice string) (*GasCost, error) {
+	price, err := StringToWei(gasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid gas price: %v", err)
+	}
+	total := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), price)
+	return &GasCost{
+		Expected: total,
+		Maximum:  new(big.Int).Set(total),
+	}, nil
+}
+
+// DynamicFeeGasCost calculates the cost of an EIP-1559 transaction.
+// The base fee, priority fee (tip) and fee cap can be any values accepted by
+// StringToWei, e.g. "30 gwei".  The expected cost uses the current base fee
+// plus the tip, limited by the fee cap; the maximum cost uses the fee cap
+func DynamicFeeGasCost(gasLimit uint64, baseFee string, tip string, feeCap string) (*GasCost, error) {
+	base, err := StringToWei(baseFee)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid base fee: %v", err)
+	}
+	priority, err := StringToWei(tip)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid tip: %v", err)
+	}
+	maxFee, err := StringToWei(feeCap)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid fee cap: %v", err)
+	}
+	if priority.Cmp(maxFee) > 0 {
+		return nil, errors.New("Tip is higher than fee cap")
+	}
+	if base.Cmp(maxFee) > 0 {
+		return nil, errors.New("Base fee is higher than fee cap")
+	}
+
+	price := EffectiveGasPrice(base, priority, maxFee)
+	gas := new(big.Int).SetUint64(gasLimit)
+	return &GasCost{
+		Expected: new(big.Int).Mul(gas, price),
+		Maximum:  new(big.Int).Mul(gas, maxFee),
+	}, nil
+}
+
+// EffectiveGasPrice returns the gas price paid by an EIP-1559 transaction,
+// which is the base fee plus the tip, limited by the fee cap
+func EffectiveGasPrice(baseFee *big.Int, tip *big.Int, feeCap *big.Int) *big.Int {
+	price := new(big.Int).Add(baseFee, tip)
+	if price.Cmp(feeCap) > 0 {
+		price.Set(feeCap)
+	}
+	return price
+}
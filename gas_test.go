@@ -0,0 +1,71 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegacyGasCost(t *testing.T) {
+	cost, err := LegacyGasCost(21000, "20 gwei")
+	assert.Nil(t, err, "Failed to calculate gas cost")
+	expected := big.NewInt(420000000000000)
+	assert.Equal(t, expected, cost.Expected, "Did not receive expected result")
+	assert.Equal(t, expected, cost.Maximum, "Did not receive expected result")
+	assert.Equal(t, "this will cost 0.00042 Ether", cost.String(), "Did not receive expected result")
+}
+
+func TestLegacyGasCostInvalidPrice(t *testing.T) {
+	_, err := LegacyGasCost(21000, "20 foo")
+	assert.NotNil(t, err, "Calculated gas cost with invalid price")
+}
+
+func TestDynamicFeeGasCost(t *testing.T) {
+	cost, err := DynamicFeeGasCost(21000, "30 gwei", "2 gwei", "100 gwei")
+	assert.Nil(t, err, "Failed to calculate gas cost")
+	assert.Equal(t, big.NewInt(672000000000000), cost.Expected, "Did not receive expected result")
+	assert.Equal(t, big.NewInt(2100000000000000), cost.Maximum, "Did not receive expected result")
+	assert.Equal(t, "this will cost 0.000672 Ether (maximum 0.0021 Ether)", cost.String(), "Did not receive expected result")
+}
+
+func TestDynamicFeeGasCostCapped(t *testing.T) {
+	cost, err := DynamicFeeGasCost(100000, "30 gwei", "5 gwei", "32 gwei")
+	assert.Nil(t, err, "Failed to calculate gas cost")
+	assert.Equal(t, cost.Maximum, cost.Expected, "Did not receive expected result")
+}
+
+func TestDynamicFeeGasCostInvalid(t *testing.T) {
+	_, err := DynamicFeeGasCost(21000, "30 gwei", "2 gwei", "1 gwei")
+	assert.NotNil(t, err, "Calculated gas cost with tip above fee cap")
+	_, err = DynamicFeeGasCost(21000, "30 gwei", "2 gwei", "20 gwei")
+	assert.NotNil(t, err, "Calculated gas cost with base fee above fee cap")
+	_, err = DynamicFeeGasCost(21000, "bad", "2 gwei", "20 gwei")
+	assert.NotNil(t, err, "Calculated gas cost with invalid base fee")
+}
+
+func TestGasCostAddValue(t *testing.T) {
+	cost, _ := LegacyGasCost(21000, "20 gwei")
+	total, err := cost.AddValue("1 ether")
+	assert.Nil(t, err, "Failed to add value")
+	assert.Equal(t, "1.00042 Ether", WeiToString(total.Expected, true), "Did not receive expected result")
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	assert.Equal(t, big.NewInt(12), EffectiveGasPrice(big.NewInt(10), big.NewInt(2), big.NewInt(20)), "Did not receive expected result")
+	assert.Equal(t, big.NewInt(11), EffectiveGasPrice(big.NewInt(10), big.NewInt(2), big.NewInt(11)), "Did not receive expected result")
+}
@@ -0,0 +1,174 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	bip39 "github.com/tyler-smith/go-bip39"
+)
+
+// hardenedKeyStart is the first index of a hardened child key
+const hardenedKeyStart = 0x80000000
+
+// NewMnemonic generates a random BIP-39 mnemonic with the given number of
+// bits of entropy, which must be a multiple of 32 between 128 and 256.
+// 128 bits gives 12 words and 256 bits gives 24 words
+func NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic checks that a BIP-39 mnemonic is made up of known words
+// and has a valid checksum
+func ValidateMnemonic(mnemonic string) error {
+	if _, err := bip39.MnemonicToByteArray(mnemonic); err != nil {
+		return fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return nil
+}
+
+// MnemonicToSeed generates the BIP-39 seed for a mnemonic and optional
+// passphrase
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// ExtendedKey is a BIP-32 extended private key
+type ExtendedKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+// NewMasterKey generates the BIP-32 master key for a seed
+func NewMasterKey(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("invalid seed length %d", len(seed))
+	}
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, errors.New("seed generates invalid master key")
+	}
+	return &ExtendedKey{
+		key:       sum[:32],
+		chainCode: sum[32:],
+	}, nil
+}
+
+// Child derives a child key.  Indices from 0x80000000 upwards give hardened
+// keys
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	data := make([]byte, 0, 37)
+	if index >= hardenedKeyStart {
+		data = append(data, 0x00)
+		data = append(data, k.key...)
+	} else {
+		privateKey, err := k.PrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, crypto.CompressPubkey(&privateKey.PublicKey)...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+	child := tweak.Add(tweak, new(big.Int).SetBytes(k.key))
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+	return &ExtendedKey{
+		key:       math.PaddedBigBytes(child, 32),
+		chainCode: sum[32:],
+	}, nil
+}
+
+// Derive derives the key at a path relative to this key
+func (k *ExtendedKey) Derive(path accounts.DerivationPath) (*ExtendedKey, error) {
+	key := k
+	for _, index := range path {
+		var err error
+		key, err = key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// PrivateKey returns the private key, for use with KeySigner or MemorySigner
+func (k *ExtendedKey) PrivateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(k.key)
+}
+
+// ChainCode returns the chain code of the key
+func (k *ExtendedKey) ChainCode() []byte {
+	return append([]byte(nil), k.chainCode...)
+}
+
+// DeriveKey derives the private key at a path such as "m/44'/60'/0'/0/0"
+// from a BIP-39 mnemonic and optional passphrase
+func DeriveKey(mnemonic string, passphrase string, path string) (*ecdsa.PrivateKey, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	master, err := NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	key, err := master.Derive(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	return key.PrivateKey()
+}
+
+// DeriveAccountKey derives the private key for the nth account of a BIP-39
+// mnemonic using the standard Ethereum path m/44'/60'/0'/0/n
+func DeriveAccountKey(mnemonic string, passphrase string, n uint32) (*ecdsa.PrivateKey, error) {
+	return DeriveKey(mnemonic, passphrase, fmt.Sprintf("m/44'/60'/0'/0/%d", n))
+}
@@ -0,0 +1,135 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mnemonic used by Hardhat and other development tools
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(128)
+	assert.Nil(t, err, "Failed to generate mnemonic")
+	assert.Equal(t, 12, len(strings.Fields(mnemonic)), "Did not receive expected number of words")
+	assert.Nil(t, ValidateMnemonic(mnemonic), "Generated invalid mnemonic")
+
+	mnemonic, err = NewMnemonic(256)
+	assert.Nil(t, err, "Failed to generate mnemonic")
+	assert.Equal(t, 24, len(strings.Fields(mnemonic)), "Did not receive expected number of words")
+
+	_, err = NewMnemonic(100)
+	assert.NotNil(t, err, "Generated mnemonic with invalid entropy size")
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	assert.Nil(t, ValidateMnemonic(testMnemonic), "Rejected valid mnemonic")
+	assert.NotNil(t, ValidateMnemonic("test test test test test test test test test test test test"), "Accepted mnemonic with bad checksum")
+	assert.NotNil(t, ValidateMnemonic("test test test test test test test test test test test notaword"), "Accepted mnemonic with unknown word")
+	assert.NotNil(t, ValidateMnemonic("test test test"), "Accepted short mnemonic")
+}
+
+func TestMnemonicToSeed(t *testing.T) {
+	// Test vector from the reference BIP-39 implementation
+	seed, err := MnemonicToSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "TREZOR")
+	assert.Nil(t, err, "Failed to generate seed")
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed), "Did not receive expected seed")
+}
+
+func TestExtendedKey(t *testing.T) {
+	// Test vector 1 from BIP-32
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path      string
+		key       string
+		chainCode string
+	}{
+		{"m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"},
+		{"m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea", "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141"},
+		{"m/0'/1", "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368", "2a7857631386ba23dacac34180dd1983734e444fdbf774041578e9b6adb37c19"},
+	}
+
+	master, err := NewMasterKey(seed)
+	assert.Nil(t, err, "Failed to generate master key")
+	for _, test := range tests {
+		path := accounts.DerivationPath{}
+		if test.path != "m" {
+			path, err = accounts.ParseDerivationPath(test.path)
+			assert.Nil(t, err, "Failed to parse path %s", test.path)
+		}
+		key, err := master.Derive(path)
+		assert.Nil(t, err, "Failed to derive %s", test.path)
+		privateKey, err := key.PrivateKey()
+		assert.Nil(t, err, "Failed to obtain private key for %s", test.path)
+		assert.Equal(t, test.key, hex.EncodeToString(crypto.FromECDSA(privateKey)), "Did not receive expected key for %s", test.path)
+		assert.Equal(t, test.chainCode, hex.EncodeToString(key.ChainCode()), "Did not receive expected chain code for %s", test.path)
+	}
+}
+
+func TestNewMasterKeyInvalidSeed(t *testing.T) {
+	_, err := NewMasterKey(make([]byte, 8))
+	assert.NotNil(t, err, "Generated master key from short seed")
+}
+
+func TestDeriveAccountKey(t *testing.T) {
+	tests := []struct {
+		n       uint32
+		address string
+	}{
+		{0, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{1, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+	}
+
+	for _, test := range tests {
+		key, err := DeriveAccountKey(testMnemonic, "", test.n)
+		assert.Nil(t, err, "Failed to derive account %d", test.n)
+		assert.Equal(t, common.HexToAddress(test.address), crypto.PubkeyToAddress(key.PublicKey), "Did not receive expected address for account %d", test.n)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	key, err := DeriveKey(testMnemonic, "", "m/44'/60'/0'/0/0")
+	assert.Nil(t, err, "Failed to derive key")
+	assert.Equal(t, "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", hex.EncodeToString(crypto.FromECDSA(key)), "Did not receive expected key")
+
+	key, err = DeriveKey("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", "m/44'/60'/0'/0/0")
+	assert.Nil(t, err, "Failed to derive key")
+	assert.Equal(t, common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), crypto.PubkeyToAddress(key.PublicKey), "Did not receive expected address")
+
+	_, err = DeriveKey(testMnemonic, "", "m/44'/60'/bad")
+	assert.NotNil(t, err, "Derived key for invalid path")
+	_, err = DeriveKey("test test test", "", "m/44'/60'/0'/0/0")
+	assert.NotNil(t, err, "Derived key from invalid mnemonic")
+}
+
+func TestDeriveKeySigner(t *testing.T) {
+	key, err := DeriveAccountKey(testMnemonic, "", 0)
+	assert.Nil(t, err, "Failed to derive key")
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	signed, err := KeySigner(testChainID, key)(address, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign transaction")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, address, sender, "Did not receive expected sender")
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// KeystoreSigner signs using an account in a keystore.  The key is decrypted
+// with the passphrase for each signature
+type KeystoreSigner struct {
+	keystore   *keystore.KeyStore
+	account    accounts.Account
+	passphrase string
+}
+
+// NewKeystoreSigner creates a signer for an account in a keystore
+func NewKeystoreSigner(ks *keystore.KeyStore, address common.Address, passphrase string) (*KeystoreSigner, error) {
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &KeystoreSigner{
+		keystore:   ks,
+		account:    account,
+		passphrase: passphrase,
+	}, nil
+}
+
+// NewKeyFileSigner creates a signer from a single encrypted key file.  The
+// key is decrypted once and held in memory
+func NewKeyFileSigner(path string, passphrase string) (*MemorySigner, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewMemorySigner(key.PrivateKey), nil
+}
+
+// Address returns the address of the account
+func (s *KeystoreSigner) Address() common.Address {
+	return s.account.Address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *KeystoreSigner) SignTx(chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	if _, err := TransactionSigner(chainID, tx); err != nil {
+		return nil, err
+	}
+	return s.keystore.SignTxWithPassphrase(s.account, s.passphrase, tx, chainID)
+}
+
+// SignHash signs a 32-byte hash
+func (s *KeystoreSigner) SignHash(hash []byte) ([]byte, error) {
+	return s.keystore.SignHashWithPassphrase(s.account, s.passphrase, hash)
+}
+
+// SignTypedData signs EIP-712 typed data
+func (s *KeystoreSigner) SignTypedData(data *apitypes.TypedData) ([]byte, error) {
+	hash, err := TypedDataHash(data)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.SignHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
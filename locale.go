@@ -0,0 +1,270 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// Locale defines the separators used when writing numbers
+type Locale struct {
+	// Decimal is the decimal separator, e.g. '.' or ','
+	Decimal rune
+	// Grouping contains the accepted digit grouping separators
+	Grouping []rune
+}
+
+// Separators used by the predefined locales
+var (
+	spaceGrouping      = []rune{' ', '\u00a0', '\u202f'}
+	commaGrouping      = []rune{','}
+	periodGrouping     = []rune{'.'}
+	apostropheGrouping = []rune{'\'', '\u2019'}
+)
+
+// Predefined locales
+var (
+	// LocaleEnglish uses a period for decimals and a comma for grouping, e.g. "1,234.5"
+	LocaleEnglish = &Locale{Decimal: '.', Grouping: commaGrouping}
+	// LocaleGerman uses a comma for decimals and a period for grouping, e.g. "1.234,5"
+	LocaleGerman = &Locale{Decimal: ',', Grouping: periodGrouping}
+	// LocaleFrench uses a comma for decimals and a space for grouping, e.g. "1 234,5"
+	LocaleFrench = &Locale{Decimal: ',', Grouping: spaceGrouping}
+	// LocaleSwiss uses a period for decimals and an apostrophe for grouping, e.g. "1'234.5"
+	LocaleSwiss = &Locale{Decimal: '.', Grouping: apostropheGrouping}
+)
+
+// Mapping of BCP-47 language tags to locales
+var locales = map[string]*Locale{
+	"en":    LocaleEnglish,
+	"ja":    LocaleEnglish,
+	"ko":    LocaleEnglish,
+	"zh":    LocaleEnglish,
+	"hi":    LocaleEnglish,
+	"de":    LocaleGerman,
+	"nl":    LocaleGerman,
+	"es":    LocaleGerman,
+	"it":    LocaleGerman,
+	"pt":    LocaleGerman,
+	"da":    LocaleGerman,
+	"id":    LocaleGerman,
+	"tr":    LocaleGerman,
+	"fr":    LocaleFrench,
+	"ru":    LocaleFrench,
+	"uk":    LocaleFrench,
+	"pl":    LocaleFrench,
+	"cs":    LocaleFrench,
+	"sk":    LocaleFrench,
+	"sv":    LocaleFrench,
+	"fi":    LocaleFrench,
+	"nb":    LocaleFrench,
+	"no":    LocaleFrench,
+	"de-ch": LocaleSwiss,
+	"fr-ch": LocaleSwiss,
+	"it-ch": LocaleSwiss,
+	"de-li": LocaleSwiss,
+}
+
+// NewLocale creates a locale with the given decimal and grouping separators
+func NewLocale(decimal rune, grouping ...rune) (*Locale, error) {
+	if unicode.IsDigit(decimal) || unicode.IsLetter(decimal) {
+		return nil, fmt.Errorf("Invalid decimal separator %q", decimal)
+	}
+	for _, separator := range grouping {
+		if separator == decimal {
+			return nil, fmt.Errorf("Grouping separator %q is the same as the decimal separator", separator)
+		}
+		if unicode.IsDigit(separator) || unicode.IsLetter(separator) {
+			return nil, fmt.Errorf("Invalid grouping separator %q", separator)
+		}
+	}
+	return &Locale{Decimal: decimal, Grouping: grouping}, nil
+}
+
+// LocaleFor obtains the locale for a BCP-47 language tag such as "de-DE".
+// If there is no entry for the full tag then the language alone is used
+func LocaleFor(tag string) (*Locale, error) {
+	tag = strings.ToLower(strings.Replace(tag, "_", "-", -1))
+	if locale, exists := locales[tag]; exists {
+		return locale, nil
+	}
+	parts := strings.Split(tag, "-")
+	if len(parts) > 1 {
+		if locale, exists := locales[parts[0]+"-"+parts[len(parts)-1]]; exists {
+			return locale, nil
+		}
+	}
+	if locale, exists := locales[parts[0]]; exists {
+		return locale, nil
+	}
+	return nil, fmt.Errorf("Unknown locale %s", tag)
+}
+
+// ParseError is returned when an amount cannot be parsed, and details
+// the part of the input that was invalid
+type ParseError struct {
+	// Input is the original input
+	Input string
+	// Offset is the byte offset of the invalid part of the input
+	Offset int
+	// Part is the invalid part of the input
+	Part string
+	// Msg describes the problem
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	if e.Part == "" {
+		return fmt.Sprintf("%s in %q", e.Msg, e.Input)
+	}
+	return fmt.Sprintf("%s: %q at position %d of %q", e.Msg, e.Part, e.Offset, e.Input)
+}
+
+func (l *Locale) isGrouping(r rune) bool {
+	for _, separator := range l.Grouping {
+		if r == separator {
+			return true
+		}
+	}
+	return false
+}
+
+// StringToWei turns a string written in this locale in to number of Wei.
+// Input is the same as for the package-level StringToWei except that the
+// locale's decimal separator is used and digits may be grouped using any of
+// the locale's grouping separators, e.g. "1.234,5 ether" for German
+func (l *Locale) StringToWei(input string) (*big.Int, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, &ParseError{Input: input, Msg: "Failed to parse empty value"}
+	}
+
+	runes := []rune(input)
+	offsets := make([]int, len(runes)+1)
+	offset := 0
+	for i, r := range runes {
+		offsets[i] = offset
+		offset += len(string(r))
+	}
+	offsets[len(runes)] = offset
+
+	isDigit := func(i int) bool {
+		return i >= 0 && i < len(runes) && runes[i] >= '0' && runes[i] <= '9'
+	}
+	fail := func(start int, end int, msg string) (*big.Int, error) {
+		return nil, &ParseError{Input: input, Offset: offsets[start], Part: input[offsets[start]:offsets[end]], Msg: msg}
+	}
+
+	// Skip leading whitespace
+	i := 0
+	for i < len(runes) && unicode.IsSpace(runes[i]) {
+		i++
+	}
+
+	// Number; this is built in the canonical form expected by
+	// unitStringToWei
+	numberStart := i
+	var number strings.Builder
+	digits := 0
+	decimal := -1
+	// Groups after the first must contain exactly three digits
+	group := -1
+	badGroup := func(end int) bool {
+		return group != -1 && decimal == -1 && end-group != 4
+	}
+	for ; i < len(runes); i++ {
+		r := runes[i]
+		if r >= '0' && r <= '9' {
+			number.WriteRune(r)
+			digits++
+			continue
+		}
+		if r == l.Decimal {
+			if decimal != -1 {
+				return fail(i, i+1, "Multiple decimal separators")
+			}
+			if badGroup(i) {
+				return fail(group, i, "Invalid digit grouping")
+			}
+			decimal = i
+			number.WriteRune('.')
+			continue
+		}
+		if l.isGrouping(r) && isDigit(i-1) && isDigit(i+1) {
+			if decimal != -1 {
+				return fail(i, i+1, "Grouping separator in fractional part")
+			}
+			if group == -1 && i-numberStart > 3 {
+				// The first group must contain at most three digits
+				return fail(numberStart, i, "Invalid digit grouping")
+			}
+			if badGroup(i) {
+				return fail(group, i, "Invalid digit grouping")
+			}
+			group = i
+			continue
+		}
+		if unicode.IsSpace(r) || unicode.IsLetter(r) {
+			break
+		}
+		if l.isGrouping(r) {
+			return fail(i, i+1, "Misplaced grouping separator")
+		}
+		return fail(i, i+1, "Invalid character")
+	}
+	numberEnd := i
+	if badGroup(numberEnd) {
+		return fail(group, numberEnd, "Invalid digit grouping")
+	}
+	if digits == 0 {
+		if numberEnd == numberStart {
+			numberEnd = len(runes)
+		}
+		return fail(numberStart, numberEnd, "Missing numeric value")
+	}
+
+	// Unit; spaces within the unit are ignored as per StringToWei
+	unitStart := -1
+	var unit strings.Builder
+	for ; i < len(runes); i++ {
+		r := runes[i]
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if unitStart == -1 {
+			if !unicode.IsLetter(r) {
+				return fail(i, i+1, "Invalid character")
+			}
+			unitStart = i
+		} else if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return fail(i, i+1, "Invalid character")
+		}
+		unit.WriteRune(r)
+	}
+	if unitStart != -1 {
+		if _, err := DefaultUnits.Lookup(unit.String()); err != nil {
+			return fail(unitStart, len(runes), "Unknown unit")
+		}
+	}
+
+	var result big.Int
+	if err := unitStringToWei(number.String(), unit.String(), &result); err != nil {
+		return fail(numberStart, numberEnd, err.Error())
+	}
+
+	return &result, nil
+}
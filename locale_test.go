@@ -0,0 +1,161 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleStringToWeiGerman(t *testing.T) {
+	expected, _ := new(big.Int).SetString("1234500000000000000000", 10)
+	result, err := LocaleGerman.StringToWei("1.234,5 ether")
+	assert.Nil(t, err, "Failed to convert German string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiFrench(t *testing.T) {
+	expected, _ := new(big.Int).SetString("1234500000000000000", 10)
+	result, err := LocaleFrench.StringToWei("1 234,5 finney")
+	assert.Nil(t, err, "Failed to convert French string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiFrenchNarrowSpace(t *testing.T) {
+	expected, _ := new(big.Int).SetString("1234000", 10)
+	result, err := LocaleFrench.StringToWei("1\u202f234 kwei")
+	assert.Nil(t, err, "Failed to convert French string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiNoUnit(t *testing.T) {
+	expected := big.NewInt(1234567)
+	result, err := LocaleEnglish.StringToWei("1,234,567")
+	assert.Nil(t, err, "Failed to convert English string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiMatchesStringToWei(t *testing.T) {
+	expected, err := StringToWei("0.024ether")
+	assert.Nil(t, err, "Failed to convert string to Wei")
+	result, err := LocaleGerman.StringToWei("0,024ether")
+	assert.Nil(t, err, "Failed to convert German string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiMultipleDecimals(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("1,234,5 ether")
+	assert.NotNil(t, err, "Converted string with multiple decimal separators")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, 5, parseErr.Offset, "Did not receive expected offset")
+	assert.Equal(t, ",", parseErr.Part, "Did not receive expected part")
+}
+
+func TestLocaleStringToWeiBadGrouping(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("1.5 ether")
+	assert.NotNil(t, err, "Converted string with bad grouping")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, ".5", parseErr.Part, "Did not receive expected part")
+}
+
+func TestLocaleStringToWeiBadFirstGroup(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("1234.567,5 ether")
+	assert.NotNil(t, err, "Converted string with bad first group")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, 0, parseErr.Offset, "Did not receive expected offset")
+	assert.Equal(t, "1234", parseErr.Part, "Did not receive expected part")
+
+	// Ungrouped values are still accepted
+	result, err := LocaleGerman.StringToWei("1234,5 ether")
+	assert.Nil(t, err, "Failed to convert ungrouped value")
+	assert.Equal(t, "1234500000000000000000", result.String(), "Did not receive expected result")
+}
+
+func TestLocaleStringToWeiGroupingInFraction(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("1,234.567 ether")
+	assert.NotNil(t, err, "Converted string with grouping in fractional part")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, 5, parseErr.Offset, "Did not receive expected offset")
+}
+
+func TestLocaleStringToWeiMisplacedGrouping(t *testing.T) {
+	_, err := LocaleGerman.StringToWei(".1234 ether")
+	assert.NotNil(t, err, "Converted string with leading grouping separator")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, 0, parseErr.Offset, "Did not receive expected offset")
+}
+
+func TestLocaleStringToWeiUnknownUnit(t *testing.T) {
+	_, err := LocaleFrench.StringToWei("1 234 foo")
+	assert.NotNil(t, err, "Converted string with bad unit")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, "foo", parseErr.Part, "Did not receive expected part")
+	assert.Equal(t, 6, parseErr.Offset, "Did not receive expected offset")
+}
+
+func TestLocaleStringToWeiFractionalWei(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("0,0001 kwei")
+	assert.NotNil(t, err, "Converted string with fractional Wei")
+	parseErr, ok := err.(*ParseError)
+	assert.True(t, ok, "Did not receive a parse error")
+	assert.Equal(t, "0,0001", parseErr.Part, "Did not receive expected part")
+}
+
+func TestLocaleStringToWeiEmpty(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("  ")
+	assert.NotNil(t, err, "Converted empty string")
+}
+
+func TestLocaleStringToWeiNegative(t *testing.T) {
+	_, err := LocaleGerman.StringToWei("-2 wei")
+	assert.NotNil(t, err, "Converted negative string")
+}
+
+func TestLocaleFor(t *testing.T) {
+	locale, err := LocaleFor("de-DE")
+	assert.Nil(t, err, "Failed to obtain locale")
+	assert.Equal(t, LocaleGerman, locale, "Did not receive expected locale")
+
+	locale, err = LocaleFor("de-CH")
+	assert.Nil(t, err, "Failed to obtain locale")
+	assert.Equal(t, LocaleSwiss, locale, "Did not receive expected locale")
+
+	locale, err = LocaleFor("en_GB")
+	assert.Nil(t, err, "Failed to obtain locale")
+	assert.Equal(t, LocaleEnglish, locale, "Did not receive expected locale")
+
+	_, err = LocaleFor("xx")
+	assert.NotNil(t, err, "Obtained unknown locale")
+}
+
+func TestNewLocale(t *testing.T) {
+	_, err := NewLocale(',', ',')
+	assert.NotNil(t, err, "Created locale with identical separators")
+
+	locale, err := NewLocale(',', '_')
+	assert.Nil(t, err, "Failed to create locale")
+	expected := big.NewInt(1000500)
+	result, err := locale.StringToWei("1_000,5 kwei")
+	assert.Nil(t, err, "Failed to convert custom locale string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
@@ -0,0 +1,63 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// MemorySigner signs using a private key held in memory
+type MemorySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewMemorySigner creates a signer from a private key
+func NewMemorySigner(key *ecdsa.PrivateKey) *MemorySigner {
+	return &MemorySigner{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+	}
+}
+
+// Address returns the address of the key
+func (s *MemorySigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *MemorySigner) SignTx(chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	txSigner, err := TransactionSigner(chainID, tx)
+	if err != nil {
+		return nil, err
+	}
+	return types.SignTx(tx, txSigner, s.key)
+}
+
+// SignHash signs a 32-byte hash
+func (s *MemorySigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
+
+// SignTypedData signs EIP-712 typed data
+func (s *MemorySigner) SignTypedData(data *apitypes.TypedData) ([]byte, error) {
+	return SignTypedDataWithKey(s.key, data)
+}
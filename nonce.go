@@ -0,0 +1,215 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// NonceSource is the part of ethclient.Client needed by NonceManager
+type NonceSource interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out nonces for accounts that send many transactions
+// concurrently, so that parallel sends do not collide on the same nonce.
+// Nonces are obtained from the node once and then tracked locally
+type NonceManager struct {
+	mutex    sync.Mutex
+	source   NonceSource
+	accounts map[common.Address]*accountNonces
+}
+
+// accountNonces tracks the nonces of a single account
+type accountNonces struct {
+	// next is the lowest nonce that has never been handed out
+	next uint64
+	// released are nonces below next that were handed out but not used
+	released []uint64
+	// inFlight are nonces that have been handed out and not yet resolved
+	inFlight map[uint64]bool
+}
+
+// NewNonceManager creates a nonce manager that synchronises with the node
+// through the given source
+func NewNonceManager(source NonceSource) *NonceManager {
+	return &NonceManager{
+		source:   source,
+		accounts: make(map[common.Address]*accountNonces),
+	}
+}
+
+// Next hands out the next nonce for an account.  Each nonce must later be
+// passed to either Sent or Release
+func (m *NonceManager) Next(ctx context.Context, address common.Address) (uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	nonces, exists := m.accounts[address]
+	if !exists {
+		next, err := m.source.PendingNonceAt(ctx, address)
+		if err != nil {
+			return 0, err
+		}
+		nonces = &accountNonces{
+			next:     next,
+			inFlight: make(map[uint64]bool),
+		}
+		m.accounts[address] = nonces
+	}
+
+	var nonce uint64
+	if len(nonces.released) > 0 {
+		// Fill gaps first, so later transactions are not stuck behind them
+		nonce = nonces.released[0]
+		nonces.released = nonces.released[1:]
+	} else {
+		for nonces.inFlight[nonces.next] {
+			nonces.next++
+		}
+		nonce = nonces.next
+		nonces.next++
+	}
+	nonces.inFlight[nonce] = true
+	return nonce, nil
+}
+
+// Sent marks a nonce as used by a transaction that was accepted by the node
+func (m *NonceManager) Sent(address common.Address, nonce uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if nonces, exists := m.accounts[address]; exists {
+		delete(nonces.inFlight, nonce)
+	}
+}
+
+// Release returns a nonce whose transaction could not be sent, so that it is
+// handed out again rather than leaving a gap that would block every later
+// transaction from the account
+func (m *NonceManager) Release(address common.Address, nonce uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	nonces, exists := m.accounts[address]
+	if !exists || !nonces.inFlight[nonce] {
+		return
+	}
+	delete(nonces.inFlight, nonce)
+	if nonce >= nonces.next {
+		// A resync has moved next back past this nonce, so it will be handed
+		// out again in order; queueing it would hand it out twice
+		return
+	}
+	if nonce+1 == nonces.next {
+		nonces.next--
+		return
+	}
+	nonces.released = append(nonces.released, nonce)
+	sort.Slice(nonces.released, func(i, j int) bool { return nonces.released[i] < nonces.released[j] })
+}
+
+// Resync refreshes an account's nonce from the node, for example after the
+// node rejects a transaction because its nonce is too low.  Released nonces
+// are discarded, and nonces still in flight are not handed out again
+func (m *NonceManager) Resync(ctx context.Context, address common.Address) error {
+	next, err := m.source.PendingNonceAt(ctx, address)
+	if err != nil {
+		return err
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	nonces, exists := m.accounts[address]
+	if !exists {
+		nonces = &accountNonces{inFlight: make(map[uint64]bool)}
+		m.accounts[address] = nonces
+	}
+	nonces.next = next
+	nonces.released = nil
+	return nil
+}
+
+// Reset forgets everything known about an account's nonces
+func (m *NonceManager) Reset(address common.Address) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.accounts, address)
+}
+
+// InFlight returns the nonces of an account that have been handed out but
+// not yet marked as sent or released, in order
+func (m *NonceManager) InFlight(address common.Address) []uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	nonces, exists := m.accounts[address]
+	if !exists {
+		return nil
+	}
+	inFlight := make([]uint64, 0, len(nonces.inFlight))
+	for nonce := range nonces.inFlight {
+		inFlight = append(inFlight, nonce)
+	}
+	sort.Slice(inFlight, func(i, j int) bool { return inFlight[i] < inFlight[j] })
+	return inFlight
+}
+
+// Transact calls a contract method, or any other function that sends a
+// transaction using bind.TransactOpts, with the nonce set from the manager.
+// The options passed to fn are a copy of opts; opts itself is not changed.
+// If the node reports that the nonce is too low the manager is resynced and
+// the call is tried once more.  To track the transactions sent, set
+// opts.Signer to a signer from TransactionTracker.BindSigner
+func (m *NonceManager) Transact(ctx context.Context, opts *bind.TransactOpts, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	tx, err := m.transact(ctx, opts, fn)
+	if err != nil && IsNonceTooLow(err) {
+		if err := m.Resync(ctx, opts.From); err != nil {
+			return nil, err
+		}
+		tx, err = m.transact(ctx, opts, fn)
+	}
+	return tx, err
+}
+
+func (m *NonceManager) transact(ctx context.Context, opts *bind.TransactOpts, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	nonce, err := m.Next(ctx, opts.From)
+	if err != nil {
+		return nil, err
+	}
+	nonceOpts := *opts
+	nonceOpts.Nonce = new(big.Int).SetUint64(nonce)
+	tx, err := fn(&nonceOpts)
+	if err != nil {
+		if IsNonceTooLow(err) {
+			// The nonce has been used elsewhere, so must not be handed out again
+			m.Sent(opts.From, nonce)
+		} else {
+			m.Release(opts.From, nonce)
+		}
+		return nil, err
+	}
+	m.Sent(opts.From, nonce)
+	return tx, nil
+}
+
+// IsNonceTooLow returns true if an error from the node says that a
+// transaction's nonce has already been used
+func IsNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "nonce too low")
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNonceSource struct {
+	mutex   sync.Mutex
+	pending uint64
+	calls   int
+}
+
+func (s *testNonceSource) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.calls++
+	return s.pending, nil
+}
+
+func TestNonceManagerNext(t *testing.T) {
+	source := &testNonceSource{pending: 5}
+	manager := NewNonceManager(source)
+	for i := uint64(5); i < 8; i++ {
+		nonce, err := manager.Next(context.Background(), testAddress)
+		assert.Nil(t, err, "Failed to obtain nonce")
+		assert.Equal(t, i, nonce, "Did not receive expected nonce")
+	}
+	assert.Equal(t, 1, source.calls, "Queried node more than once")
+	assert.Equal(t, []uint64{5, 6, 7}, manager.InFlight(testAddress), "Did not receive expected in-flight nonces")
+
+	// Accounts are tracked separately
+	nonce, _ := manager.Next(context.Background(), testRecipient)
+	assert.Equal(t, uint64(5), nonce, "Did not receive expected nonce")
+}
+
+func TestNonceManagerConcurrent(t *testing.T) {
+	manager := NewNonceManager(&testNonceSource{})
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	seen := make(map[uint64]bool)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background(), testAddress)
+			assert.Nil(t, err, "Failed to obtain nonce")
+			mutex.Lock()
+			assert.False(t, seen[nonce], "Nonce %d handed out twice", nonce)
+			seen[nonce] = true
+			mutex.Unlock()
+			manager.Sent(testAddress, nonce)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 50, len(seen), "Did not receive expected number of nonces")
+	assert.Equal(t, 0, len(manager.InFlight(testAddress)), "Nonces still in flight")
+}
+
+func TestNonceManagerRelease(t *testing.T) {
+	manager := NewNonceManager(&testNonceSource{})
+	for i := 0; i < 4; i++ {
+		manager.Next(context.Background(), testAddress)
+	}
+	manager.Sent(testAddress, 0)
+	manager.Sent(testAddress, 3)
+
+	// Gaps are filled in order
+	manager.Release(testAddress, 2)
+	manager.Release(testAddress, 1)
+	nonce, _ := manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(1), nonce, "Did not receive expected nonce")
+	nonce, _ = manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(2), nonce, "Did not receive expected nonce")
+	nonce, _ = manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(4), nonce, "Did not receive expected nonce")
+
+	// Releasing the latest nonce winds back
+	manager.Release(testAddress, 4)
+	nonce, _ = manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(4), nonce, "Did not receive expected nonce")
+
+	// Releasing a nonce that is not in flight does nothing
+	manager.Release(testAddress, 0)
+	nonce, _ = manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(5), nonce, "Did not receive expected nonce")
+}
+
+func TestNonceManagerResync(t *testing.T) {
+	source := &testNonceSource{}
+	manager := NewNonceManager(source)
+	manager.Next(context.Background(), testAddress)
+	manager.Next(context.Background(), testAddress)
+
+	// Another sender has used nonces up to 10; nonce 10 is still ours
+	source.pending = 10
+	manager.Next(context.Background(), testAddress)
+	manager.Release(testAddress, 0)
+	assert.Nil(t, manager.Resync(context.Background(), testAddress), "Failed to resync")
+	nonce, _ := manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(10), nonce, "Did not receive expected nonce")
+
+	source.pending = 12
+	manager.Reset(testAddress)
+	nonce, _ = manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(12), nonce, "Did not receive expected nonce")
+}
+
+func TestNonceManagerResyncThenRelease(t *testing.T) {
+	source := &testNonceSource{pending: 5}
+	manager := NewNonceManager(source)
+	for i := 0; i < 4; i++ {
+		manager.Next(context.Background(), testAddress)
+	}
+	for nonce := uint64(5); nonce < 8; nonce++ {
+		manager.Sent(testAddress, nonce)
+	}
+
+	// Node has not yet seen nonces 5 to 7, and 8 fails to send
+	assert.Nil(t, manager.Resync(context.Background(), testAddress), "Failed to resync")
+	manager.Release(testAddress, 8)
+	for i := uint64(5); i < 10; i++ {
+		nonce, _ := manager.Next(context.Background(), testAddress)
+		assert.Equal(t, i, nonce, "Did not receive expected nonce")
+	}
+}
+
+func TestNonceManagerResyncSkipsInFlight(t *testing.T) {
+	source := &testNonceSource{}
+	manager := NewNonceManager(source)
+	manager.Next(context.Background(), testAddress)
+	manager.Next(context.Background(), testAddress)
+
+	// Node has not yet seen nonce 1, which is still in flight
+	source.pending = 1
+	assert.Nil(t, manager.Resync(context.Background(), testAddress), "Failed to resync")
+	nonce, _ := manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(2), nonce, "Did not receive expected nonce")
+}
+
+func TestNonceManagerTransact(t *testing.T) {
+	manager := NewNonceManager(&testNonceSource{pending: 3})
+	opts := &bind.TransactOpts{From: testAddress}
+	tx, err := manager.Transact(context.Background(), opts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return types.NewTransaction(opts.Nonce.Uint64(), testRecipient, nil, 21000, nil, nil), nil
+	})
+	assert.Nil(t, err, "Failed to transact")
+	assert.Equal(t, uint64(3), tx.Nonce(), "Did not receive expected nonce")
+	assert.Nil(t, opts.Nonce, "Original options changed")
+	assert.Equal(t, 0, len(manager.InFlight(testAddress)), "Nonces still in flight")
+}
+
+func TestNonceManagerTransactFailure(t *testing.T) {
+	manager := NewNonceManager(&testNonceSource{})
+	opts := &bind.TransactOpts{From: testAddress}
+	_, err := manager.Transact(context.Background(), opts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return nil, errors.New("insufficient funds for gas * price + value")
+	})
+	assert.NotNil(t, err, "Did not receive error")
+	nonce, _ := manager.Next(context.Background(), testAddress)
+	assert.Equal(t, uint64(0), nonce, "Failed nonce not released")
+}
+
+func TestNonceManagerTransactNonceTooLow(t *testing.T) {
+	source := &testNonceSource{}
+	manager := NewNonceManager(source)
+	opts := &bind.TransactOpts{From: testAddress}
+	manager.Next(context.Background(), testAddress)
+	manager.Sent(testAddress, 0)
+
+	// Another sender has used nonces up to 7
+	source.pending = 7
+	var nonces []uint64
+	tx, err := manager.Transact(context.Background(), opts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		nonces = append(nonces, opts.Nonce.Uint64())
+		if opts.Nonce.Uint64() < source.pending {
+			return nil, errors.New("nonce too low: next nonce 7, tx nonce 1")
+		}
+		return types.NewTransaction(opts.Nonce.Uint64(), testRecipient, nil, 21000, nil, nil), nil
+	})
+	assert.Nil(t, err, "Failed to transact")
+	assert.Equal(t, []uint64{1, 7}, nonces, "Did not receive expected nonces")
+	assert.Equal(t, uint64(7), tx.Nonce(), "Did not receive expected nonce")
+}
+
+func TestIsNonceTooLow(t *testing.T) {
+	assert.True(t, IsNonceTooLow(errors.New("nonce too low")), "Did not detect nonce too low")
+	assert.False(t, IsNonceTooLow(errors.New("replacement transaction underpriced")), "Detected nonce too low")
+	assert.False(t, IsNonceTooLow(nil), "Detected nonce too low")
+}
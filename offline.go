@@ -0,0 +1,263 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// UnsignedTransactionVersion is the version of the unsigned transaction
+// envelope format
+const UnsignedTransactionVersion = 1
+
+// UnsignedTransaction is an envelope holding everything needed to sign a
+// transaction away from the network, for example on an air-gapped machine
+// with a cold-storage key.  It is built online, moved to the signing
+// machine as JSON, and the resulting raw transaction moved back to be
+// broadcast
+type UnsignedTransaction struct {
+	Version              int              `json:"version"`
+	Type                 uint8            `json:"type"`
+	ChainID              *big.Int         `json:"chainId"`
+	From                 common.Address   `json:"from"`
+	To                   *common.Address  `json:"to,omitempty"`
+	Nonce                uint64           `json:"nonce"`
+	Gas                  uint64           `json:"gas"`
+	GasPrice             *Wei             `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *Wei             `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *Wei             `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *Wei             `json:"value"`
+	Data                 hexutil.Bytes    `json:"data,omitempty"`
+	AccessList           types.AccessList `json:"accessList,omitempty"`
+	// Summary is a human-readable description of the transaction as it was
+	// built.  It travels with the envelope so can be changed with it; show
+	// the operator Describe() before signing rather than trusting it
+	Summary string `json:"summary"`
+}
+
+// NewUnsignedTransaction creates an envelope for an unsigned transaction to
+// be sent from the given address
+func NewUnsignedTransaction(chainID *big.Int, from common.Address, tx *types.Transaction) (*UnsignedTransaction, error) {
+	if _, err := TransactionSigner(chainID, tx); err != nil {
+		return nil, err
+	}
+	u := &UnsignedTransaction{
+		Version: UnsignedTransactionVersion,
+		Type:    tx.Type(),
+		ChainID: new(big.Int).Set(chainID),
+		From:    from,
+		To:      tx.To(),
+		Nonce:   tx.Nonce(),
+		Gas:     tx.Gas(),
+		Value:   NewWei(tx.Value()),
+		Data:    tx.Data(),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		u.GasPrice = NewWei(tx.GasPrice())
+	case types.AccessListTxType:
+		u.GasPrice = NewWei(tx.GasPrice())
+		u.AccessList = envelopeAccessList(tx.AccessList())
+	case types.DynamicFeeTxType:
+		u.MaxFeePerGas = NewWei(tx.GasFeeCap())
+		u.MaxPriorityFeePerGas = NewWei(tx.GasTipCap())
+		u.AccessList = envelopeAccessList(tx.AccessList())
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+	u.Summary = u.Describe()
+	return u, nil
+}
+
+// envelopeAccessList copies an access list, giving every entry a non-nil
+// list of storage keys; entries with null storage keys fail to parse
+func envelopeAccessList(list types.AccessList) types.AccessList {
+	if list == nil {
+		return nil
+	}
+	result := make(types.AccessList, len(list))
+	for i, tuple := range list {
+		result[i] = types.AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]common.Hash{}, tuple.StorageKeys...),
+		}
+	}
+	return result
+}
+
+// ParseUnsignedTransaction parses the JSON form of an unsigned transaction
+func ParseUnsignedTransaction(input []byte) (*UnsignedTransaction, error) {
+	u := &UnsignedTransaction{}
+	if err := json.Unmarshal(input, u); err != nil {
+		return nil, err
+	}
+	if u.Version != UnsignedTransactionVersion {
+		return nil, fmt.Errorf("unsupported unsigned transaction version %d", u.Version)
+	}
+	if _, err := u.Transaction(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Transaction creates the unsigned transaction held in the envelope
+func (u *UnsignedTransaction) Transaction() (*types.Transaction, error) {
+	if u.ChainID == nil || u.ChainID.Sign() <= 0 {
+		return nil, errors.New("missing chain ID")
+	}
+	if u.Value == nil {
+		return nil, errors.New("missing value")
+	}
+	switch u.Type {
+	case types.LegacyTxType:
+		if u.GasPrice == nil {
+			return nil, errors.New("missing gas price")
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    u.Nonce,
+			GasPrice: new(big.Int).Set(u.GasPrice.Int()),
+			Gas:      u.Gas,
+			To:       u.To,
+			Value:    new(big.Int).Set(u.Value.Int()),
+			Data:     u.Data,
+		}), nil
+	case types.AccessListTxType:
+		if u.GasPrice == nil {
+			return nil, errors.New("missing gas price")
+		}
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    new(big.Int).Set(u.ChainID),
+			Nonce:      u.Nonce,
+			GasPrice:   new(big.Int).Set(u.GasPrice.Int()),
+			Gas:        u.Gas,
+			To:         u.To,
+			Value:      new(big.Int).Set(u.Value.Int()),
+			Data:       u.Data,
+			AccessList: u.AccessList,
+		}), nil
+	case types.DynamicFeeTxType:
+		if u.MaxFeePerGas == nil || u.MaxPriorityFeePerGas == nil {
+			return nil, errors.New("missing fee cap or tip")
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    new(big.Int).Set(u.ChainID),
+			Nonce:      u.Nonce,
+			GasTipCap:  new(big.Int).Set(u.MaxPriorityFeePerGas.Int()),
+			GasFeeCap:  new(big.Int).Set(u.MaxFeePerGas.Int()),
+			Gas:        u.Gas,
+			To:         u.To,
+			Value:      new(big.Int).Set(u.Value.Int()),
+			Data:       u.Data,
+			AccessList: u.AccessList,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", u.Type)
+	}
+}
+
+// Describe returns a human-readable description of the transaction, e.g.
+// "send 1.5 Ether from 0x... to 0x... on chain 1 with nonce 3; gas will cost at most 0.0021 Ether"
+func (u *UnsignedTransaction) Describe() string {
+	var action string
+	if u.To == nil {
+		action = fmt.Sprintf("deploy contract with %d bytes of code from %s", len(u.Data), u.From.Hex())
+		if u.Value != nil && u.Value.Int().Sign() != 0 {
+			action += fmt.Sprintf(" sending %s", weiText(u.Value))
+		}
+	} else {
+		action = fmt.Sprintf("send %s from %s to %s", weiText(u.Value), u.From.Hex(), u.To.Hex())
+		if len(u.Data) > 0 {
+			action += fmt.Sprintf(" with %d bytes of data", len(u.Data))
+		}
+	}
+
+	price := u.GasPrice
+	if u.MaxFeePerGas != nil {
+		price = u.MaxFeePerGas
+	}
+	fees := "unknown"
+	if price != nil {
+		fees = WeiToString(new(big.Int).Mul(new(big.Int).SetUint64(u.Gas), price.Int()), true)
+	}
+	return fmt.Sprintf("%s on chain %v with nonce %d; gas will cost at most %s", action, u.ChainID, u.Nonce, fees)
+}
+
+func weiText(value *Wei) string {
+	if value == nil {
+		return "unknown value"
+	}
+	return WeiToString(value.Int(), true)
+}
+
+// SignOffline signs the transaction in an envelope, returning the raw
+// transaction ready to be broadcast.  reviewed is the description, from
+// Describe(), that the operator approved; it must match the transaction in
+// the envelope, so that a change made after the review is not signed
+func SignOffline(signer Signer, u *UnsignedTransaction, reviewed string) (hexutil.Bytes, error) {
+	if u.From != signer.Address() {
+		return nil, fmt.Errorf("transaction is from %s but signer is %s", u.From.Hex(), signer.Address().Hex())
+	}
+	if reviewed != u.Describe() {
+		return nil, errors.New("reviewed summary does not match transaction")
+	}
+	tx, err := u.Transaction()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := signer.SignTx(u.ChainID, tx)
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
+
+// TransactionBroadcaster is the part of ethclient.Client needed to broadcast
+// transactions
+type TransactionBroadcaster interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Broadcast submits a raw signed transaction to the network, after checking
+// that it is for the same chain as the client.  The transaction is not
+// tracked; pass the result to TransactionTracker.Track to record it
+func Broadcast(ctx context.Context, client TransactionBroadcaster, raw []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid raw transaction: %v", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tx.ChainId().Sign() != 0 && tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("transaction chain ID %v does not match network chain ID %v", tx.ChainId(), chainID)
+	}
+	if _, err := types.Sender(types.LatestSignerForChainID(chainID), tx); err != nil {
+		return nil, fmt.Errorf("invalid signature: %v", err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
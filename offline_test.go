@@ -0,0 +1,144 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBroadcaster struct {
+	chainID *big.Int
+	sent    []*types.Transaction
+}
+
+func (b *testBroadcaster) ChainID(ctx context.Context) (*big.Int, error) {
+	return b.chainID, nil
+}
+
+func (b *testBroadcaster) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func TestUnsignedTransactionSummary(t *testing.T) {
+	u, err := NewUnsignedTransaction(testChainID, testAddress, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to create unsigned transaction")
+	assert.Equal(t, "send 1 Wei from "+testAddress.Hex()+" to "+testRecipient.Hex()+" on chain 1 with nonce 0; gas will cost at most 0.0021 Ether", u.Summary, "Did not receive expected summary")
+
+	tx := types.NewContractCreation(3, big.NewInt(0), 100000, big.NewInt(20000000000), []byte{0x60, 0x80})
+	u, err = NewUnsignedTransaction(testChainID, testAddress, tx)
+	assert.Nil(t, err, "Failed to create unsigned transaction")
+	assert.Equal(t, "deploy contract with 2 bytes of code from "+testAddress.Hex()+" on chain 1 with nonce 3; gas will cost at most 0.002 Ether", u.Summary, "Did not receive expected summary")
+}
+
+func TestUnsignedTransactionJSON(t *testing.T) {
+	txs := []*types.Transaction{
+		types.NewTransaction(1, testRecipient, big.NewInt(1000000000000000000), 21000, big.NewInt(20000000000), nil),
+		types.NewTx(&types.AccessListTx{
+			ChainID:    testChainID,
+			Nonce:      2,
+			GasPrice:   big.NewInt(20000000000),
+			Gas:        30000,
+			To:         &testRecipient,
+			Value:      big.NewInt(0),
+			Data:       []byte{0x01, 0x02},
+			AccessList: types.AccessList{{Address: testRecipient}},
+		}),
+		testDynamicFeeTx(testChainID),
+	}
+
+	for _, tx := range txs {
+		u, err := NewUnsignedTransaction(testChainID, testAddress, tx)
+		assert.Nil(t, err, "Failed to create unsigned transaction")
+		data, err := json.Marshal(u)
+		assert.Nil(t, err, "Failed to marshal unsigned transaction")
+		parsed, err := ParseUnsignedTransaction(data)
+		require.Nil(t, err, "Failed to parse unsigned transaction")
+		assert.Equal(t, u.Summary, parsed.Summary, "Did not receive expected summary")
+		rebuilt, err := parsed.Transaction()
+		assert.Nil(t, err, "Failed to rebuild transaction")
+		signer := types.LatestSignerForChainID(testChainID)
+		assert.Equal(t, signer.Hash(tx), signer.Hash(rebuilt), "Did not receive expected transaction")
+	}
+}
+
+func TestParseUnsignedTransactionInvalid(t *testing.T) {
+	inputs := []string{
+		`not json`,
+		`{"version":2,"type":0,"chainId":1,"nonce":0,"gas":21000,"gasPrice":"1","value":"0"}`,
+		`{"version":1,"type":0,"nonce":0,"gas":21000,"gasPrice":"1","value":"0"}`,
+		`{"version":1,"type":0,"chainId":1,"nonce":0,"gas":21000,"value":"0"}`,
+		`{"version":1,"type":2,"chainId":1,"nonce":0,"gas":21000,"gasPrice":"1","value":"0"}`,
+		`{"version":1,"type":3,"chainId":1,"nonce":0,"gas":21000,"gasPrice":"1","value":"0"}`,
+	}
+	for _, input := range inputs {
+		_, err := ParseUnsignedTransaction([]byte(input))
+		assert.NotNil(t, err, "Parsed invalid unsigned transaction %s", input)
+	}
+}
+
+func TestSignOffline(t *testing.T) {
+	u, _ := NewUnsignedTransaction(testChainID, testAddress, testDynamicFeeTx(testChainID))
+	raw, err := SignOffline(NewMemorySigner(testKey), u, u.Describe())
+	assert.Nil(t, err, "Failed to sign offline")
+
+	broadcaster := &testBroadcaster{chainID: testChainID}
+	tx, err := Broadcast(context.Background(), broadcaster, raw)
+	assert.Nil(t, err, "Failed to broadcast transaction")
+	assert.Equal(t, 1, len(broadcaster.sent), "Did not send transaction")
+	assert.Equal(t, tx.Hash(), broadcaster.sent[0].Hash(), "Did not send expected transaction")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), tx)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+}
+
+func TestSignOfflineWrongSigner(t *testing.T) {
+	u, _ := NewUnsignedTransaction(testChainID, testRecipient, testDynamicFeeTx(testChainID))
+	_, err := SignOffline(NewMemorySigner(testKey), u, u.Describe())
+	assert.NotNil(t, err, "Signed transaction from another account")
+}
+
+func TestSignOfflineChangedAfterReview(t *testing.T) {
+	u, _ := NewUnsignedTransaction(testChainID, testAddress, testDynamicFeeTx(testChainID))
+	reviewed := u.Describe()
+	// Regenerating the envelope's own summary does not hide the change
+	u.Value = NewWei(big.NewInt(1000000000000000000))
+	u.Summary = u.Describe()
+	_, err := SignOffline(NewMemorySigner(testKey), u, reviewed)
+	assert.NotNil(t, err, "Signed transaction that does not match the reviewed summary")
+	_, err = SignOffline(NewMemorySigner(testKey), u, u.Summary)
+	assert.Nil(t, err, "Failed to sign reviewed transaction")
+}
+
+func TestBroadcastWrongChain(t *testing.T) {
+	u, _ := NewUnsignedTransaction(testChainID, testAddress, testDynamicFeeTx(testChainID))
+	raw, _ := SignOffline(NewMemorySigner(testKey), u, u.Describe())
+	broadcaster := &testBroadcaster{chainID: big.NewInt(5)}
+	_, err := Broadcast(context.Background(), broadcaster, raw)
+	assert.NotNil(t, err, "Broadcast transaction to another chain")
+	assert.Equal(t, 0, len(broadcaster.sent), "Sent transaction to another chain")
+}
+
+func TestBroadcastInvalid(t *testing.T) {
+	_, err := Broadcast(context.Background(), &testBroadcaster{chainID: testChainID}, []byte{0x01, 0x02})
+	assert.NotNil(t, err, "Broadcast invalid transaction")
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// PersonalMessageHash returns the EIP-191 hash of a message as used by
+// personal_sign, keccak256("\x19Ethereum Signed Message:\n" || len(message) || message)
+func PersonalMessageHash(message []byte) []byte {
+	return accounts.TextHash(message)
+}
+
+// SignPersonalMessageWithKey signs a message as per personal_sign using a
+// private key.  The signature is 65 bytes [R || S || V] where V is 27 or 28
+func SignPersonalMessageWithKey(key *ecdsa.PrivateKey, message []byte) ([]byte, error) {
+	signature, err := crypto.Sign(PersonalMessageHash(message), key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// SignPersonalMessageWithAccount signs a message as per personal_sign using
+// an account.  The signature is 65 bytes [R || S || V] where V is 27 or 28
+func SignPersonalMessageWithAccount(wallet *accounts.Wallet, account *accounts.Account, passphrase string, message []byte) ([]byte, error) {
+	signature, err := (*wallet).SignTextWithPassphrase(*account, passphrase, message)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// SignPersonalMessage signs a message as per personal_sign using a Signer.
+// The signature is 65 bytes [R || S || V] where V is 27 or 28
+func SignPersonalMessage(s Signer, message []byte) ([]byte, error) {
+	signature, err := s.SignHash(PersonalMessageHash(message))
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// RecoverPersonalMessage recovers the address that signed a message as per
+// personal_sign.  The V value of the signature can be 27 or 28, or 0 or 1
+func RecoverPersonalMessage(message []byte, signature []byte) (common.Address, error) {
+	return recoverAddress(PersonalMessageHash(message), signature)
+}
+
+// VerifyPersonalMessage checks that a message was signed by an address as
+// per personal_sign
+func VerifyPersonalMessage(message []byte, signature []byte, address common.Address) (bool, error) {
+	signer, err := RecoverPersonalMessage(message, signature)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMessage = []byte("Hello World")
+
+func TestPersonalMessageHash(t *testing.T) {
+	assert.Equal(t, "0xa1de988600a42c4b4ab089b619297c17d53cffae5d5120d82d8a92d0bb3b78f2", hexutil.Encode(PersonalMessageHash(testMessage)), "Did not receive expected hash")
+}
+
+func TestSignPersonalMessageWithKey(t *testing.T) {
+	signature, err := SignPersonalMessageWithKey(testKey, testMessage)
+	assert.Nil(t, err, "Failed to sign message")
+	assert.Equal(t, 65, len(signature), "Did not receive expected signature length")
+	assert.True(t, signature[64] == 27 || signature[64] == 28, "Did not receive expected recovery ID")
+	address, err := RecoverPersonalMessage(testMessage, signature)
+	assert.Nil(t, err, "Failed to recover address")
+	assert.Equal(t, testAddress, address, "Did not receive expected address")
+}
+
+func TestSignPersonalMessageWithAccount(t *testing.T) {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(testKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	wallet := ks.Wallets()[0]
+	signature, err := SignPersonalMessageWithAccount(&wallet, &account, "secret", testMessage)
+	assert.Nil(t, err, "Failed to sign message")
+	expected, _ := SignPersonalMessageWithKey(testKey, testMessage)
+	assert.Equal(t, expected, signature, "Did not receive expected signature")
+
+	_, err = SignPersonalMessageWithAccount(&wallet, &account, "wrong", testMessage)
+	assert.NotNil(t, err, "Signed with bad passphrase")
+}
+
+func TestSignPersonalMessage(t *testing.T) {
+	signature, err := SignPersonalMessage(newTestKeystoreSigner(t), testMessage)
+	assert.Nil(t, err, "Failed to sign message")
+	expected, _ := SignPersonalMessageWithKey(testKey, testMessage)
+	assert.Equal(t, expected, signature, "Did not receive expected signature")
+}
+
+func TestRecoverPersonalMessageZeroRecoveryID(t *testing.T) {
+	signature, _ := SignPersonalMessageWithKey(testKey, testMessage)
+	signature[64] -= 27
+	address, err := RecoverPersonalMessage(testMessage, signature)
+	assert.Nil(t, err, "Failed to recover address")
+	assert.Equal(t, testAddress, address, "Did not receive expected address")
+}
+
+func TestRecoverPersonalMessageInvalidSignature(t *testing.T) {
+	signature, _ := SignPersonalMessageWithKey(testKey, testMessage)
+	_, err := RecoverPersonalMessage(testMessage, signature[:64])
+	assert.NotNil(t, err, "Recovered address from short signature")
+
+	invalid := common.CopyBytes(signature)
+	invalid[64] = 2
+	_, err = RecoverPersonalMessage(testMessage, invalid)
+	assert.NotNil(t, err, "Recovered address with invalid recovery ID")
+}
+
+func TestVerifyPersonalMessage(t *testing.T) {
+	signature, _ := SignPersonalMessageWithKey(testKey, testMessage)
+	verified, err := VerifyPersonalMessage(testMessage, signature, testAddress)
+	assert.Nil(t, err, "Failed to verify message")
+	assert.True(t, verified, "Failed to verify signature")
+
+	verified, err = VerifyPersonalMessage([]byte("Hello world"), signature, testAddress)
+	assert.Nil(t, err, "Failed to verify message")
+	assert.False(t, verified, "Verified signature for altered message")
+
+	verified, err = VerifyPersonalMessage(testMessage, signature, testRecipient)
+	assert.Nil(t, err, "Failed to verify message")
+	assert.False(t, verified, "Verified signature for wrong address")
+}
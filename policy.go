@@ -0,0 +1,245 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SigningPolicy is a set of rules that a transaction must satisfy before it
+// is signed.  Empty rules are not enforced
+type SigningPolicy struct {
+	// MaxValue is the most that can be sent in a single transaction, as
+	// accepted by StringToWei, e.g. "1.5 ether"
+	MaxValue string
+	// MaxGasPrice is the highest gas price, or fee cap for EIP-1559
+	// transactions, as accepted by StringToWei, e.g. "100 gwei"
+	MaxGasPrice string
+	// Recipients are the addresses that transactions can be sent to
+	Recipients []common.Address
+	// Methods are the contract methods that can be called, either as
+	// signatures, e.g. "transfer(address,uint256)", or as 4-byte selectors,
+	// e.g. "0xa9059cbb".  Transactions without calldata are always allowed
+	Methods []string
+	// AllowContractCreation allows transactions that deploy contracts
+	AllowContractCreation bool
+}
+
+// AuditRecord is a record of a request to sign a transaction.  Approved is
+// set if the transaction satisfied the policy, and Signed only if it was then
+// signed; Reason explains why either is not set
+type AuditRecord struct {
+	Time     time.Time       `json:"time"`
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to,omitempty"`
+	ChainID  *big.Int        `json:"chainId,omitempty"`
+	Type     uint8           `json:"type"`
+	Nonce    uint64          `json:"nonce"`
+	Gas      uint64          `json:"gas"`
+	GasPrice *Wei            `json:"gasPrice"`
+	Value    *Wei            `json:"value"`
+	Selector string          `json:"selector,omitempty"`
+	Approved bool            `json:"approved"`
+	Signed   bool            `json:"signed"`
+	Reason   string          `json:"reason,omitempty"`
+	Hash     *common.Hash    `json:"hash,omitempty"`
+}
+
+// AuditLog stores audit records
+type AuditLog interface {
+	Record(record *AuditRecord) error
+}
+
+// JSONAuditLog writes audit records as lines of JSON
+type JSONAuditLog struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewJSONAuditLog creates an audit log that writes to the given writer
+func NewJSONAuditLog(writer io.Writer) *JSONAuditLog {
+	return &JSONAuditLog{
+		writer: writer,
+	}
+}
+
+// Record writes an audit record
+func (l *JSONAuditLog) Record(record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, err = l.writer.Write(append(data, '\n'))
+	return err
+}
+
+// compiledPolicy is a signing policy with its values parsed
+type compiledPolicy struct {
+	maxValue              *big.Int
+	maxGasPrice           *big.Int
+	recipients            map[common.Address]bool
+	methods               map[[4]byte]bool
+	allowContractCreation bool
+}
+
+func (p *SigningPolicy) compile() (*compiledPolicy, error) {
+	compiled := &compiledPolicy{
+		allowContractCreation: p.AllowContractCreation,
+	}
+	var err error
+	if p.MaxValue != "" {
+		if compiled.maxValue, err = StringToWei(p.MaxValue); err != nil {
+			return nil, fmt.Errorf("invalid maximum value: %v", err)
+		}
+	}
+	if p.MaxGasPrice != "" {
+		if compiled.maxGasPrice, err = StringToWei(p.MaxGasPrice); err != nil {
+			return nil, fmt.Errorf("invalid maximum gas price: %v", err)
+		}
+	}
+	if p.Recipients != nil {
+		compiled.recipients = make(map[common.Address]bool)
+		for _, recipient := range p.Recipients {
+			compiled.recipients[recipient] = true
+		}
+	}
+	if p.Methods != nil {
+		compiled.methods = make(map[[4]byte]bool)
+		for _, method := range p.Methods {
+			selector, err := methodSelector(method)
+			if err != nil {
+				return nil, err
+			}
+			compiled.methods[selector] = true
+		}
+	}
+	return compiled, nil
+}
+
+// methodSelector obtains the selector for a method signature or hex selector
+func methodSelector(method string) ([4]byte, error) {
+	var selector [4]byte
+	if strings.HasPrefix(method, "0x") {
+		data, err := hexutil.Decode(method)
+		if err != nil || len(data) != 4 {
+			return selector, fmt.Errorf("invalid method selector %s", method)
+		}
+		copy(selector[:], data)
+		return selector, nil
+	}
+	if !strings.Contains(method, "(") || !strings.HasSuffix(method, ")") {
+		return selector, fmt.Errorf("invalid method signature %s", method)
+	}
+	copy(selector[:], crypto.Keccak256([]byte(method)))
+	return selector, nil
+}
+
+// check returns an error describing the first rule the transaction breaks
+func (p *compiledPolicy) check(tx *types.Transaction) error {
+	if p.maxValue != nil && tx.Value().Cmp(p.maxValue) > 0 {
+		return fmt.Errorf("value %s exceeds maximum %s", WeiToString(tx.Value(), true), WeiToString(p.maxValue, true))
+	}
+	if p.maxGasPrice != nil && tx.GasFeeCap().Cmp(p.maxGasPrice) > 0 {
+		return fmt.Errorf("gas price %s exceeds maximum %s", WeiToString(tx.GasFeeCap(), true), WeiToString(p.maxGasPrice, true))
+	}
+	if tx.To() == nil {
+		if !p.allowContractCreation {
+			return errors.New("contract creation not allowed")
+		}
+		return nil
+	}
+	if p.recipients != nil && !p.recipients[*tx.To()] {
+		return fmt.Errorf("recipient %s not allowed", tx.To().Hex())
+	}
+	if p.methods != nil && len(tx.Data()) > 0 {
+		if len(tx.Data()) < 4 {
+			return errors.New("calldata too short for method selector")
+		}
+		var selector [4]byte
+		copy(selector[:], tx.Data())
+		if !p.methods[selector] {
+			return fmt.Errorf("method %s not allowed", hexutil.Encode(selector[:]))
+		}
+	}
+	return nil
+}
+
+// PolicySigner wraps a bind signer function, such as that generated by
+// KeySigner or AccountSigner, so that each transaction is checked against a
+// policy before it is signed.  Every request is written to the audit log
+// whether it is approved or rejected; if the record cannot be written the
+// signed transaction is not released
+func PolicySigner(signerfn bind.SignerFn, policy *SigningPolicy, audit AuditLog) (bind.SignerFn, error) {
+	compiled, err := policy.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		record := &AuditRecord{
+			Time:     time.Now().UTC(),
+			From:     address,
+			To:       tx.To(),
+			Type:     tx.Type(),
+			Nonce:    tx.Nonce(),
+			Gas:      tx.Gas(),
+			GasPrice: NewWei(tx.GasFeeCap()),
+			Value:    NewWei(tx.Value()),
+		}
+		if tx.Type() != types.LegacyTxType {
+			record.ChainID = tx.ChainId()
+		}
+		if len(tx.Data()) >= 4 {
+			record.Selector = hexutil.Encode(tx.Data()[:4])
+		}
+
+		if err := compiled.check(tx); err != nil {
+			record.Reason = err.Error()
+			if auditErr := audit.Record(record); auditErr != nil {
+				return nil, fmt.Errorf("failed to write audit record: %v", auditErr)
+			}
+			return nil, fmt.Errorf("transaction rejected by policy: %v", err)
+		}
+
+		record.Approved = true
+		signed, err := signerfn(address, tx)
+		if err != nil {
+			record.Reason = err.Error()
+		} else {
+			record.Signed = true
+			hash := signed.Hash()
+			record.Hash = &hash
+		}
+		if auditErr := audit.Record(record); auditErr != nil {
+			return nil, fmt.Errorf("failed to write audit record: %v", auditErr)
+		}
+		return signed, err
+	}, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPolicy = &SigningPolicy{
+	MaxValue:    "1 ether",
+	MaxGasPrice: "100 gwei",
+	Recipients:  []common.Address{testRecipient},
+	Methods:     []string{"transfer(address,uint256)", "0x095ea7b3"},
+}
+
+func testPolicyTx(value *big.Int, feeCap *big.Int, to *common.Address, data []byte) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: feeCap,
+		Gas:       100000,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+}
+
+func policySign(t *testing.T, policy *SigningPolicy, tx *types.Transaction) (*types.Transaction, *AuditRecord, error) {
+	var buf bytes.Buffer
+	signerfn, err := PolicySigner(KeySigner(testChainID, testKey), policy, NewJSONAuditLog(&buf))
+	assert.Nil(t, err, "Failed to create policy signer")
+	signed, err := signerfn(testAddress, tx)
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"), "Did not receive expected number of audit records")
+	var record AuditRecord
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record), "Failed to decode audit record")
+	return signed, &record, err
+}
+
+func TestPolicySignerApproved(t *testing.T) {
+	transfer := append(hexutil.MustDecode("0xa9059cbb"), make([]byte, 64)...)
+	signed, record, err := policySign(t, testPolicy, testPolicyTx(big.NewInt(0), big.NewInt(50000000000), &testRecipient, transfer))
+	assert.Nil(t, err, "Failed to sign transaction")
+	assert.True(t, record.Approved, "Audit record not approved")
+	assert.True(t, record.Signed, "Audit record not signed")
+	assert.Equal(t, "", record.Reason, "Did not receive expected reason")
+	assert.Equal(t, signed.Hash(), *record.Hash, "Did not receive expected hash")
+	assert.Equal(t, testAddress, record.From, "Did not receive expected from")
+	assert.Equal(t, testRecipient, *record.To, "Did not receive expected to")
+	assert.Equal(t, "0xa9059cbb", record.Selector, "Did not receive expected selector")
+	assert.Equal(t, big.NewInt(50000000000), record.GasPrice.Int(), "Did not receive expected gas price")
+	assert.Equal(t, testChainID, record.ChainID, "Did not receive expected chain ID")
+}
+
+func TestPolicySignerSelector(t *testing.T) {
+	approve := hexutil.MustDecode("0x095ea7b3")
+	_, record, err := policySign(t, testPolicy, testPolicyTx(big.NewInt(0), big.NewInt(50000000000), &testRecipient, approve))
+	assert.Nil(t, err, "Failed to sign transaction")
+	assert.True(t, record.Approved, "Audit record not approved")
+}
+
+func TestPolicySignerPlainTransfer(t *testing.T) {
+	_, record, err := policySign(t, testPolicy, testPolicyTx(big.NewInt(1000000000000000000), big.NewInt(100000000000), &testRecipient, nil))
+	assert.Nil(t, err, "Failed to sign transaction")
+	assert.True(t, record.Approved, "Audit record not approved")
+}
+
+func TestPolicySignerRejected(t *testing.T) {
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tests := []struct {
+		name   string
+		tx     *types.Transaction
+		reason string
+	}{
+		{"value", testPolicyTx(big.NewInt(1000000000000000001), big.NewInt(50000000000), &testRecipient, nil), "value 1.000000000000000001 Ether exceeds maximum 1 Ether"},
+		{"gas price", testPolicyTx(big.NewInt(0), big.NewInt(100000000001), &testRecipient, nil), "gas price 100.000000001 GWei exceeds maximum 100 GWei"},
+		{"recipient", testPolicyTx(big.NewInt(0), big.NewInt(50000000000), &other, nil), "recipient 0x0000000000000000000000000000000000000001 not allowed"},
+		{"method", testPolicyTx(big.NewInt(0), big.NewInt(50000000000), &testRecipient, hexutil.MustDecode("0x23b872dd")), "method 0x23b872dd not allowed"},
+		{"short calldata", testPolicyTx(big.NewInt(0), big.NewInt(50000000000), &testRecipient, []byte{0x01}), "calldata too short for method selector"},
+		{"contract creation", testPolicyTx(big.NewInt(0), big.NewInt(50000000000), nil, []byte{0x60, 0x80}), "contract creation not allowed"},
+	}
+
+	for _, test := range tests {
+		_, record, err := policySign(t, testPolicy, test.tx)
+		assert.NotNil(t, err, "Signed transaction breaking %s rule", test.name)
+		assert.False(t, record.Approved, "Audit record approved for %s", test.name)
+		assert.False(t, record.Signed, "Audit record signed for %s", test.name)
+		assert.Equal(t, test.reason, record.Reason, "Did not receive expected reason for %s", test.name)
+		assert.Nil(t, record.Hash, "Received hash for %s", test.name)
+	}
+}
+
+func TestPolicySignerEmptyPolicy(t *testing.T) {
+	_, record, err := policySign(t, &SigningPolicy{AllowContractCreation: true}, testPolicyTx(big.NewInt(0), big.NewInt(50000000000), nil, []byte{0x60, 0x80}))
+	assert.Nil(t, err, "Failed to sign transaction")
+	assert.True(t, record.Approved, "Audit record not approved")
+	assert.Nil(t, record.To, "Received recipient for contract creation")
+}
+
+func TestPolicySignerSigningFailure(t *testing.T) {
+	var buf bytes.Buffer
+	signerfn, _ := PolicySigner(KeySigner(testChainID, testKey), &SigningPolicy{}, NewJSONAuditLog(&buf))
+	_, err := signerfn(testRecipient, testPolicyTx(big.NewInt(0), big.NewInt(1), &testRecipient, nil))
+	assert.NotNil(t, err, "Signed transaction for another account")
+	var record AuditRecord
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &record), "Failed to decode audit record")
+	assert.True(t, record.Approved, "Audit record not approved")
+	assert.False(t, record.Signed, "Audit record signed")
+	assert.Equal(t, "not authorized to sign this account", record.Reason, "Did not receive expected reason")
+	assert.Nil(t, record.Hash, "Received hash for failed signature")
+}
+
+type failingAuditLog struct{}
+
+func (l *failingAuditLog) Record(record *AuditRecord) error {
+	return errors.New("disk full")
+}
+
+func TestPolicySignerAuditFailure(t *testing.T) {
+	signerfn, _ := PolicySigner(KeySigner(testChainID, testKey), &SigningPolicy{}, &failingAuditLog{})
+	signed, err := signerfn(testAddress, testPolicyTx(big.NewInt(0), big.NewInt(1), &testRecipient, nil))
+	assert.NotNil(t, err, "Released transaction without audit record")
+	assert.Nil(t, signed, "Released transaction without audit record")
+}
+
+func TestPolicySignerInvalidPolicy(t *testing.T) {
+	for _, policy := range []*SigningPolicy{
+		{MaxValue: "lots"},
+		{MaxGasPrice: "1 fast"},
+		{Methods: []string{"0x1234"}},
+		{Methods: []string{"transfer"}},
+	} {
+		_, err := PolicySigner(KeySigner(testChainID, testKey), policy, &failingAuditLog{})
+		assert.NotNil(t, err, "Accepted invalid policy")
+	}
+}
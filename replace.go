@@ -0,0 +1,190 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MinReplacementBump is the minimum percentage by which the fees of a
+// replacement transaction must rise for nodes to accept it
+const MinReplacementBump = 10
+
+// ReplacementBackend is the part of ethclient.Client needed to replace
+// pending transactions
+type ReplacementBackend interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// ReplacementOptions configure how a pending transaction is replaced
+type ReplacementOptions struct {
+	// BumpPercent is the percentage by which fees are raised.  Defaults to,
+	// and cannot be less than, MinReplacementBump
+	BumpPercent int
+	// MaxFee is the highest gas price, or fee cap for EIP-1559
+	// transactions, that the replacement can have, as accepted by
+	// StringToWei, e.g. "200 gwei".  No limit if empty
+	MaxFee string
+	// Tracker, if set, records the replacement before it is sent, with a
+	// purpose such as "speed up 0x..." naming the original transaction
+	Tracker *TransactionTracker
+}
+
+// SpeedUpTransaction replaces a pending transaction with an identical one
+// that has higher fees, signed by the same signer.  The replacement is sent
+// and returned
+func SpeedUpTransaction(ctx context.Context, backend ReplacementBackend, chainID *big.Int, signer Signer, hash common.Hash, opts *ReplacementOptions) (*types.Transaction, error) {
+	return replaceTransaction(ctx, backend, chainID, signer, hash, opts, false)
+}
+
+// CancelTransaction replaces a pending transaction with a zero-value
+// transfer from the signer to itself with higher fees, so that if the
+// replacement is mined the original transaction can never be.  The
+// replacement is sent and returned
+func CancelTransaction(ctx context.Context, backend ReplacementBackend, chainID *big.Int, signer Signer, hash common.Hash, opts *ReplacementOptions) (*types.Transaction, error) {
+	return replaceTransaction(ctx, backend, chainID, signer, hash, opts, true)
+}
+
+func replaceTransaction(ctx context.Context, backend ReplacementBackend, chainID *big.Int, signer Signer, hash common.Hash, opts *ReplacementOptions, cancel bool) (*types.Transaction, error) {
+	bump := MinReplacementBump
+	var maxFee *big.Int
+	if opts != nil {
+		if opts.BumpPercent > bump {
+			bump = opts.BumpPercent
+		}
+		if opts.MaxFee != "" {
+			var err error
+			if maxFee, err = StringToWei(opts.MaxFee); err != nil {
+				return nil, fmt.Errorf("invalid maximum fee: %v", err)
+			}
+		}
+	}
+
+	tx, pending, err := backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !pending {
+		return nil, errors.New("transaction is no longer pending")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != signer.Address() {
+		return nil, fmt.Errorf("transaction was sent by %s rather than %s", sender.Hex(), signer.Address().Hex())
+	}
+
+	var replacement *types.Transaction
+	if cancel {
+		// Cancel by sending nothing to ourselves.  An access list would raise
+		// the intrinsic gas above that of a plain transfer, so is dropped
+		replacement, err = rebuildTransaction(tx, bump, &sender, new(big.Int), 21000, nil, nil)
+	} else {
+		replacement, err = ReplacementTransaction(tx, bump)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if maxFee != nil && replacement.GasFeeCap().Cmp(maxFee) > 0 {
+		return nil, fmt.Errorf("replacement fee %s exceeds maximum %s", WeiToString(replacement.GasFeeCap(), true), WeiToString(maxFee, true))
+	}
+
+	signed, err := signer.SignTx(chainID, replacement)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.Tracker != nil {
+		purpose := "speed up " + hash.Hex()
+		if cancel {
+			purpose = "cancel " + hash.Hex()
+		}
+		if _, err = opts.Tracker.Track(chainID, signed, purpose); err != nil {
+			return nil, fmt.Errorf("failed to track transaction: %v", err)
+		}
+	}
+	if err = backend.SendTransaction(ctx, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// ReplacementTransaction creates an unsigned copy of a transaction with
+// its fees raised by the given percentage
+func ReplacementTransaction(tx *types.Transaction, bumpPercent int) (*types.Transaction, error) {
+	return rebuildTransaction(tx, bumpPercent, tx.To(), tx.Value(), tx.Gas(), tx.Data(), tx.AccessList())
+}
+
+// rebuildTransaction creates an unsigned transaction of the same type and
+// with the same nonce as tx, with raised fees and the given contents
+func rebuildTransaction(tx *types.Transaction, bumpPercent int, to *common.Address, value *big.Int, gas uint64, data []byte, accessList types.AccessList) (*types.Transaction, error) {
+	if bumpPercent < MinReplacementBump {
+		return nil, fmt.Errorf("fee bump must be at least %d%%", MinReplacementBump)
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpFee(tx.GasPrice(), bumpPercent),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}), nil
+	case types.AccessListTxType:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasPrice:   bumpFee(tx.GasPrice(), bumpPercent),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		}), nil
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  bumpFee(tx.GasTipCap(), bumpPercent),
+			GasFeeCap:  bumpFee(tx.GasFeeCap(), bumpPercent),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		}), nil
+	default:
+		return nil, fmt.Errorf("cannot replace transaction of type %d", tx.Type())
+	}
+}
+
+// bumpFee raises a fee by a percentage, rounding up so that the result is
+// always strictly higher
+func bumpFee(fee *big.Int, percent int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(int64(100+percent)))
+	bumped = divRound(bumped, big.NewInt(100), RoundCeiling)
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
@@ -0,0 +1,214 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var replaceChainID = big.NewInt(1337)
+
+// testReplacementBackend holds transactions in memory
+type testReplacementBackend struct {
+	txs     map[common.Hash]*types.Transaction
+	pending map[common.Hash]bool
+	sent    []*types.Transaction
+}
+
+func newTestReplacementBackend() *testReplacementBackend {
+	return &testReplacementBackend{
+		txs:     make(map[common.Hash]*types.Transaction),
+		pending: make(map[common.Hash]bool),
+	}
+}
+
+// add signs a transaction with the test key and holds it as pending
+func (b *testReplacementBackend) add(t *testing.T, tx *types.Transaction) *types.Transaction {
+	signed, err := NewMemorySigner(testKey).SignTx(replaceChainID, tx)
+	assert.Nil(t, err, "Failed to sign transaction")
+	b.txs[signed.Hash()] = signed
+	b.pending[signed.Hash()] = true
+	return signed
+}
+
+func (b *testReplacementBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	tx, exists := b.txs[hash]
+	if !exists {
+		return nil, false, ethereum.NotFound
+	}
+	return tx, b.pending[hash], nil
+}
+
+func (b *testReplacementBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func TestSpeedUpLegacyTransaction(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTransaction(4, testRecipient, big.NewInt(1000), 50000, big.NewInt(20000000001), []byte{0x01}))
+
+	tx, err := SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), nil)
+	assert.Nil(t, err, "Failed to speed up transaction")
+	assert.Equal(t, []*types.Transaction{tx}, backend.sent, "Did not send replacement")
+	assert.Equal(t, uint64(4), tx.Nonce(), "Did not receive expected nonce")
+	// 10% rounded up
+	assert.Equal(t, big.NewInt(22000000002), tx.GasPrice(), "Did not receive expected gas price")
+	assert.Equal(t, original.To(), tx.To(), "Did not receive expected recipient")
+	assert.Equal(t, original.Value(), tx.Value(), "Did not receive expected value")
+	assert.Equal(t, original.Data(), tx.Data(), "Did not receive expected data")
+	assert.Equal(t, original.Gas(), tx.Gas(), "Did not receive expected gas")
+
+	sender, err := types.Sender(types.LatestSignerForChainID(replaceChainID), tx)
+	assert.Nil(t, err, "Failed to obtain sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+}
+
+func TestSpeedUpDynamicFeeTransaction(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   replaceChainID,
+		Nonce:     2,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(30000000000),
+		Gas:       21000,
+		To:        &testRecipient,
+		Value:     big.NewInt(1),
+	}))
+
+	tx, err := SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), &ReplacementOptions{BumpPercent: 50})
+	assert.Nil(t, err, "Failed to speed up transaction")
+	assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type(), "Did not receive expected type")
+	assert.Equal(t, big.NewInt(1500000000), tx.GasTipCap(), "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(45000000000), tx.GasFeeCap(), "Did not receive expected fee cap")
+}
+
+func TestCancelTransaction(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   replaceChainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(30000000000),
+		Gas:       200000,
+		To:        &testRecipient,
+		Value:     big.NewInt(1000000),
+		Data:      []byte{0xa9, 0x05, 0x9c, 0xbb},
+	}))
+
+	tx, err := CancelTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), nil)
+	assert.Nil(t, err, "Failed to cancel transaction")
+	assert.Equal(t, uint64(7), tx.Nonce(), "Did not receive expected nonce")
+	assert.Equal(t, testAddress, *tx.To(), "Did not receive expected recipient")
+	assert.Equal(t, int64(0), tx.Value().Int64(), "Did not receive expected value")
+	assert.Equal(t, 0, len(tx.Data()), "Did not receive expected data")
+	assert.Equal(t, uint64(21000), tx.Gas(), "Did not receive expected gas")
+	assert.Equal(t, big.NewInt(1100000000), tx.GasTipCap(), "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(33000000000), tx.GasFeeCap(), "Did not receive expected fee cap")
+}
+
+func TestCancelAccessListTransaction(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTx(&types.AccessListTx{
+		ChainID:  replaceChainID,
+		Nonce:    5,
+		GasPrice: big.NewInt(20000000000),
+		Gas:      100000,
+		To:       &testRecipient,
+		Value:    big.NewInt(0),
+		Data:     []byte{0xa9, 0x05, 0x9c, 0xbb},
+		AccessList: types.AccessList{{
+			Address:     testRecipient,
+			StorageKeys: []common.Hash{common.HexToHash("0x01")},
+		}},
+	}))
+
+	tx, err := CancelTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), nil)
+	assert.Nil(t, err, "Failed to cancel transaction")
+	assert.Equal(t, uint8(types.AccessListTxType), tx.Type(), "Did not receive expected type")
+	assert.Equal(t, uint64(21000), tx.Gas(), "Did not receive expected gas")
+	// Access list would take intrinsic gas above 21000
+	assert.Equal(t, 0, len(tx.AccessList()), "Cancel kept access list")
+	assert.Equal(t, big.NewInt(22000000000), tx.GasPrice(), "Did not receive expected gas price")
+
+	// Speeding up keeps the access list
+	tx, err = SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), nil)
+	assert.Nil(t, err, "Failed to speed up transaction")
+	assert.Equal(t, original.AccessList(), tx.AccessList(), "Did not receive expected access list")
+}
+
+func TestReplaceTransactionTracked(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTransaction(3, testRecipient, big.NewInt(0), 21000, big.NewInt(1000000000), nil))
+	tracker, _ := newTestTracker(t)
+
+	sped, err := SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), &ReplacementOptions{Tracker: tracker})
+	assert.Nil(t, err, "Failed to speed up transaction")
+	cancelled, err := CancelTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), &ReplacementOptions{BumpPercent: 50, Tracker: tracker})
+	assert.Nil(t, err, "Failed to cancel transaction")
+
+	txs, _ := tracker.Transactions()
+	assert.Equal(t, 2, len(txs), "Did not receive expected number of transactions")
+	assert.Equal(t, sped.Hash(), txs[0].Hash, "Did not receive expected hash")
+	assert.Equal(t, "speed up "+original.Hash().Hex(), txs[0].Purpose, "Did not receive expected purpose")
+	assert.Equal(t, cancelled.Hash(), txs[1].Hash, "Did not receive expected hash")
+	assert.Equal(t, "cancel "+original.Hash().Hex(), txs[1].Purpose, "Did not receive expected purpose")
+	assert.Equal(t, uint64(3), txs[1].Nonce, "Did not receive expected nonce")
+}
+
+func TestReplaceTransactionMaxFee(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTransaction(0, testRecipient, big.NewInt(0), 21000, big.NewInt(200000000000), nil))
+
+	_, err := SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), &ReplacementOptions{MaxFee: "200 gwei"})
+	assert.NotNil(t, err, "Did not receive error")
+	assert.Equal(t, 0, len(backend.sent), "Sent replacement over maximum fee")
+}
+
+func TestReplaceTransactionNotPending(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTransaction(0, testRecipient, big.NewInt(0), 21000, big.NewInt(1000000000), nil))
+	backend.pending[original.Hash()] = false
+
+	_, err := SpeedUpTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(testKey), original.Hash(), nil)
+	assert.NotNil(t, err, "Did not receive error")
+}
+
+func TestReplaceTransactionWrongSigner(t *testing.T) {
+	backend := newTestReplacementBackend()
+	original := backend.add(t, types.NewTransaction(0, testRecipient, big.NewInt(0), 21000, big.NewInt(1000000000), nil))
+	key, _ := DeriveAccountKey("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", 0)
+
+	_, err := CancelTransaction(context.Background(), backend, replaceChainID, NewMemorySigner(key), original.Hash(), nil)
+	assert.NotNil(t, err, "Did not receive error")
+	assert.Equal(t, 0, len(backend.sent), "Sent replacement from wrong signer")
+}
+
+func TestReplacementTransactionBump(t *testing.T) {
+	_, err := ReplacementTransaction(types.NewTransaction(0, testRecipient, nil, 21000, big.NewInt(100), nil), 5)
+	assert.NotNil(t, err, "Accepted bump below minimum")
+
+	// Small fees still rise
+	tx, err := ReplacementTransaction(types.NewTransaction(0, testRecipient, nil, 21000, big.NewInt(1), nil), MinReplacementBump)
+	assert.Nil(t, err, "Failed to create replacement")
+	assert.Equal(t, big.NewInt(2), tx.GasPrice(), "Did not receive expected gas price")
+}
@@ -0,0 +1,152 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RouterSigner holds signers for many accounts, from any mix of sources, and
+// dispatches each request to the signer for the relevant address
+type RouterSigner struct {
+	mutex   sync.RWMutex
+	signers map[common.Address]Signer
+}
+
+// NewRouterSigner creates a router holding the given signers
+func NewRouterSigner(signers ...Signer) (*RouterSigner, error) {
+	r := &RouterSigner{
+		signers: make(map[common.Address]Signer),
+	}
+	for _, signer := range signers {
+		if err := r.Add(signer); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Add adds a signer to the router.  There cannot already be a signer for the
+// same address
+func (r *RouterSigner) Add(signer Signer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	address := signer.Address()
+	if _, exists := r.signers[address]; exists {
+		return fmt.Errorf("signer for %s already present", address.Hex())
+	}
+	r.signers[address] = signer
+	return nil
+}
+
+// AddKey adds a signer for a private key to the router
+func (r *RouterSigner) AddKey(key *ecdsa.PrivateKey) error {
+	return r.Add(NewMemorySigner(key))
+}
+
+// AddKeystore adds signers for the accounts in a keystore that have a
+// passphrase supplied.  Accounts that already have a signer are skipped, so
+// the same account found in more than one keystore is only added once.  It
+// returns the number of signers added
+func (r *RouterSigner) AddKeystore(ks *keystore.KeyStore, passphrases map[common.Address]string) (int, error) {
+	added := 0
+	for _, account := range ks.Accounts() {
+		passphrase, exists := passphrases[account.Address]
+		if !exists || r.Has(account.Address) {
+			continue
+		}
+		signer, err := NewKeystoreSigner(ks, account.Address, passphrase)
+		if err != nil {
+			return added, err
+		}
+		if err = r.Add(signer); err != nil {
+			return added, err
+		}
+		added++
+	}
+	return added, nil
+}
+
+// Remove removes the signer for an address from the router
+func (r *RouterSigner) Remove(address common.Address) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.signers, address)
+}
+
+// Has returns true if the router has a signer for an address
+func (r *RouterSigner) Has(address common.Address) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	_, exists := r.signers[address]
+	return exists
+}
+
+// Signer returns the signer for an address
+func (r *RouterSigner) Signer(address common.Address) (Signer, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	signer, exists := r.signers[address]
+	if !exists {
+		return nil, fmt.Errorf("no signer for account %s", address.Hex())
+	}
+	return signer, nil
+}
+
+// Addresses returns the addresses the router can sign for, in order
+func (r *RouterSigner) Addresses() []common.Address {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	addresses := make([]common.Address, 0, len(r.signers))
+	for address := range r.signers {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	return addresses
+}
+
+// SignTx signs a transaction for the given chain using the signer for an
+// address
+func (r *RouterSigner) SignTx(address common.Address, chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	signer, err := r.Signer(address)
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignTx(chainID, tx)
+}
+
+// BindSigner generates a bind signer function that signs with whichever
+// signer matches the From address of bind.TransactOpts
+func (r *RouterSigner) BindSigner(chainID *big.Int) (signerfn bind.SignerFn) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if _, err := TransactionSigner(chainID, tx); err != nil {
+			return nil, err
+		}
+		return r.SignTx(address, chainID, tx)
+	}
+	return
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouterSigner(t *testing.T) {
+	otherKey, _ := crypto.GenerateKey()
+	otherAddress := crypto.PubkeyToAddress(otherKey.PublicKey)
+	router, err := NewRouterSigner(NewMemorySigner(testKey))
+	assert.Nil(t, err, "Failed to create router")
+	assert.Nil(t, router.AddKey(otherKey), "Failed to add key")
+
+	signerfn := router.BindSigner(testChainID)
+	for _, address := range []common.Address{testAddress, otherAddress} {
+		signed, err := signerfn(address, testDynamicFeeTx(testChainID))
+		assert.Nil(t, err, "Failed to sign transaction")
+		sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+		assert.Nil(t, err, "Failed to recover sender")
+		assert.Equal(t, address, sender, "Did not receive expected sender")
+	}
+
+	_, err = signerfn(testRecipient, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed transaction for unknown account")
+}
+
+func TestRouterSignerDuplicate(t *testing.T) {
+	_, err := NewRouterSigner(NewMemorySigner(testKey), NewMemorySigner(testKey))
+	assert.NotNil(t, err, "Added duplicate signer")
+}
+
+func TestRouterSignerRemove(t *testing.T) {
+	router, _ := NewRouterSigner(NewMemorySigner(testKey))
+	assert.True(t, router.Has(testAddress), "Signer not present")
+	router.Remove(testAddress)
+	assert.False(t, router.Has(testAddress), "Signer still present")
+	_, err := router.Signer(testAddress)
+	assert.NotNil(t, err, "Obtained removed signer")
+}
+
+func TestRouterSignerAddKeystore(t *testing.T) {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err := ks.ImportECDSA(testKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	otherKey, _ := crypto.GenerateKey()
+	other, err := ks.ImportECDSA(otherKey, "other")
+	assert.Nil(t, err, "Failed to import key")
+	skippedKey, _ := crypto.GenerateKey()
+	_, err = ks.ImportECDSA(skippedKey, "skipped")
+	assert.Nil(t, err, "Failed to import key")
+
+	router, _ := NewRouterSigner(NewMemorySigner(testKey))
+	added, err := router.AddKeystore(ks, map[common.Address]string{
+		testAddress:   "secret",
+		other.Address: "other",
+	})
+	assert.Nil(t, err, "Failed to add keystore")
+	assert.Equal(t, 1, added, "Did not add expected number of signers")
+
+	expected := []common.Address{testAddress, other.Address}
+	if bytes.Compare(testAddress[:], other.Address[:]) > 0 {
+		expected = []common.Address{other.Address, testAddress}
+	}
+	assert.Equal(t, expected, router.Addresses(), "Did not receive expected addresses")
+
+	signer, err := router.Signer(other.Address)
+	assert.Nil(t, err, "Failed to obtain signer")
+	_, isKeystore := signer.(*KeystoreSigner)
+	assert.True(t, isKeystore, "Did not receive keystore signer")
+	_, err = router.SignTx(other.Address, testChainID, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign transaction")
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var errSignerLocked = errors.New("signer is locked")
+
+// SessionSigner signs using a keystore account that is unlocked once, so the
+// cost of decrypting the key is paid up front rather than for each signature.
+// The key is held in memory until the session times out or Lock is called,
+// at which point it is zeroed
+type SessionSigner struct {
+	mutex   sync.Mutex
+	address common.Address
+	signer  *MemorySigner
+	timer   *time.Timer
+}
+
+// NewSessionSigner unlocks a keystore account for the given time.  A timeout
+// of 0 keeps the account unlocked until Lock is called
+func NewSessionSigner(account *accounts.Account, passphrase string, timeout time.Duration) (*SessionSigner, error) {
+	if account.URL.Scheme != keystore.KeyStoreScheme {
+		return nil, fmt.Errorf("account %s is not in a keystore", account.Address.Hex())
+	}
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if key.Address != account.Address {
+		zeroBigInt(key.PrivateKey.D)
+		return nil, fmt.Errorf("key file contains %s rather than %s", key.Address.Hex(), account.Address.Hex())
+	}
+
+	s := &SessionSigner{
+		address: account.Address,
+		signer:  NewMemorySigner(key.PrivateKey),
+	}
+	if timeout > 0 {
+		// Hold the lock so that a short timeout cannot fire before the
+		// timer is stored
+		s.mutex.Lock()
+		s.timer = time.AfterFunc(timeout, s.Lock)
+		s.mutex.Unlock()
+	}
+	return s, nil
+}
+
+// Lock zeroes the key and ends the session.  Subsequent attempts to sign
+// will fail
+func (s *SessionSigner) Lock() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.signer != nil {
+		zeroBigInt(s.signer.key.D)
+		s.signer = nil
+	}
+}
+
+// Unlocked returns true if the session has not yet ended
+func (s *SessionSigner) Unlocked() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.signer != nil
+}
+
+// Address returns the address of the account
+func (s *SessionSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction for the given chain
+func (s *SessionSigner) SignTx(chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.signer == nil {
+		return nil, errSignerLocked
+	}
+	return s.signer.SignTx(chainID, tx)
+}
+
+// SignHash signs a 32-byte hash
+func (s *SessionSigner) SignHash(hash []byte) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.signer == nil {
+		return nil, errSignerLocked
+	}
+	return s.signer.SignHash(hash)
+}
+
+// SignTypedData signs EIP-712 typed data
+func (s *SessionSigner) SignTypedData(data *apitypes.TypedData) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.signer == nil {
+		return nil, errSignerLocked
+	}
+	return s.signer.SignTypedData(data)
+}
+
+// zeroBigInt overwrites the value of a big integer in place
+func zeroBigInt(value *big.Int) {
+	words := value.Bits()
+	for i := range words {
+		words[i] = 0
+	}
+	value.SetInt64(0)
+}
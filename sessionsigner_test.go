@@ -0,0 +1,91 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSessionAccount(t *testing.T) *accounts.Account {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(testKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	return &account
+}
+
+func TestSessionSigner(t *testing.T) {
+	signer, err := NewSessionSigner(newTestSessionAccount(t), "secret", 0)
+	assert.Nil(t, err, "Failed to create session signer")
+	defer signer.Lock()
+	testSigner(t, signer)
+	assert.True(t, signer.Unlocked(), "Session ended early")
+}
+
+func TestSessionSignerBadPassphrase(t *testing.T) {
+	_, err := NewSessionSigner(newTestSessionAccount(t), "wrong", 0)
+	assert.NotNil(t, err, "Unlocked with bad passphrase")
+}
+
+func TestSessionSignerNotKeystore(t *testing.T) {
+	account := &accounts.Account{Address: testAddress, URL: accounts.URL{Scheme: "ledger", Path: "/"}}
+	_, err := NewSessionSigner(account, "secret", 0)
+	assert.NotNil(t, err, "Unlocked account outside of keystore")
+}
+
+func TestSessionSignerLock(t *testing.T) {
+	signer, err := NewSessionSigner(newTestSessionAccount(t), "secret", 0)
+	assert.Nil(t, err, "Failed to create session signer")
+	key := signer.signer.key
+	signer.Lock()
+	assert.False(t, signer.Unlocked(), "Session did not end")
+	assert.Equal(t, 0, key.D.Sign(), "Key was not zeroed")
+
+	_, err = signer.SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed transaction after lock")
+	_, err = signer.SignHash(crypto.Keccak256([]byte("test")))
+	assert.NotNil(t, err, "Signed hash after lock")
+	_, err = signer.SignTypedData(testTypedData)
+	assert.NotNil(t, err, "Signed typed data after lock")
+
+	// Locking again is harmless
+	signer.Lock()
+}
+
+func TestSessionSignerTimeout(t *testing.T) {
+	signer, err := NewSessionSigner(newTestSessionAccount(t), "secret", 50*time.Millisecond)
+	assert.Nil(t, err, "Failed to create session signer")
+	key := signer.signer.key
+	_, err = signer.SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign transaction during session")
+
+	time.Sleep(200 * time.Millisecond)
+	assert.False(t, signer.Unlocked(), "Session did not time out")
+	assert.Equal(t, 0, key.D.Sign(), "Key was not zeroed")
+	_, err = signer.SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed transaction after timeout")
+}
+
+func TestSessionSignerShortTimeout(t *testing.T) {
+	// Timer can fire while the session is still being created
+	signer, err := NewSessionSigner(newTestSessionAccount(t), "secret", time.Nanosecond)
+	assert.Nil(t, err, "Failed to create session signer")
+	assert.Eventually(t, func() bool { return !signer.Unlocked() }, time.Second, 10*time.Millisecond, "Session did not time out")
+}
@@ -0,0 +1,76 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// StringToSignedWei turns a string in to a possibly negative number of Wei.
+// The string takes the same form as for StringToWei, optionally preceded by
+// a '+' or '-' sign, or enclosed in parentheses to denote a negative value as
+// used in ledgers, e.g. "-0.5 ether" or "(0.5 ether)"
+func StringToSignedWei(input string) (*big.Int, error) {
+	value := strings.TrimSpace(input)
+	if value == "" {
+		return nil, errors.New("Failed to parse empty value")
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") {
+		if !strings.HasSuffix(value, ")") {
+			return nil, fmt.Errorf("Unbalanced parentheses in %s", input)
+		}
+		negative = true
+		value = strings.TrimSpace(value[1 : len(value)-1])
+	} else if strings.HasPrefix(value, "-") {
+		negative = true
+		value = strings.TrimSpace(value[1:])
+	} else if strings.HasPrefix(value, "+") {
+		value = strings.TrimSpace(value[1:])
+	}
+	// Signs are allowed later in the value, for example in exponents or unit
+	// names, but not a second leading sign or stray parentheses
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") || strings.ContainsAny(value, "()") {
+		return nil, fmt.Errorf("Invalid sign in %s", input)
+	}
+
+	result, err := StringToWei(value)
+	if err != nil {
+		return nil, err
+	}
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// SignedWeiToString turns a possibly negative number of Wei in to a string.
+// The unit is chosen from the magnitude of the value as per WeiToString, and
+// negative values are preceded by a '-' sign or, if 'parentheses' is true,
+// enclosed in parentheses, e.g. "-0.5 Ether" or "(0.5 Ether)"
+func SignedWeiToString(input *big.Int, standard bool, parentheses bool) string {
+	if input.Sign() >= 0 {
+		return WeiToString(input, standard)
+	}
+	magnitude := WeiToString(new(big.Int).Neg(input), standard)
+	if parentheses {
+		return fmt.Sprintf("(%s)", magnitude)
+	}
+	return fmt.Sprintf("-%s", magnitude)
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToSignedWeiNegative(t *testing.T) {
+	expected, _ := new(big.Int).SetString("-500000000000000000", 10)
+	result, err := StringToSignedWei("-0.5 ether")
+	assert.Nil(t, err, "Failed to convert negative string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToSignedWeiParentheses(t *testing.T) {
+	expected, _ := new(big.Int).SetString("-500000000000000000", 10)
+	result, err := StringToSignedWei("(0.5 ether)")
+	assert.Nil(t, err, "Failed to convert parenthesised string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToSignedWeiPositive(t *testing.T) {
+	expected := big.NewInt(2000000000)
+	result, err := StringToSignedWei("+2 gwei")
+	assert.Nil(t, err, "Failed to convert positive string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+	result, err = StringToSignedWei("2 gwei")
+	assert.Nil(t, err, "Failed to convert unsigned string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToSignedWeiExponent(t *testing.T) {
+	expected, _ := new(big.Int).SetString("-2000000000000000", 10)
+	result, err := StringToSignedWei("-2e-3 ether")
+	assert.Nil(t, err, "Failed to convert negative exponent string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+	result, err = StringToSignedWei("(2e-3 ether)")
+	assert.Nil(t, err, "Failed to convert parenthesised exponent string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestStringToSignedWeiInvalid(t *testing.T) {
+	for _, input := range []string{"", "--2 wei", "-(2 wei)", "(2 wei", "+-2 wei", "-", "()"} {
+		_, err := StringToSignedWei(input)
+		assert.NotNil(t, err, "Converted invalid string %q to Wei", input)
+	}
+}
+
+func TestStringToWeiStillRejectsNegative(t *testing.T) {
+	_, err := StringToWei("-0.5 ether")
+	assert.NotNil(t, err, "Converted negative string with unsigned function")
+}
+
+func TestSignedWeiToString(t *testing.T) {
+	wei, _ := new(big.Int).SetString("-500000000000000000", 10)
+	assert.Equal(t, "-0.5 Ether", SignedWeiToString(wei, true, false), "Did not receive expected result")
+	assert.Equal(t, "(0.5 Ether)", SignedWeiToString(wei, true, true), "Did not receive expected result")
+	assert.Equal(t, "-500 Milliether", SignedWeiToString(wei, false, false), "Did not receive expected result")
+	assert.Equal(t, "1 Wei", SignedWeiToString(big.NewInt(1), true, true), "Did not receive expected result")
+	assert.Equal(t, "0", SignedWeiToString(big.NewInt(0), true, true), "Did not receive expected result")
+}
+
+func TestSignedWeiRoundTrip(t *testing.T) {
+	wei, _ := new(big.Int).SetString("-1234567890000000000", 10)
+	for _, parentheses := range []bool{false, true} {
+		result, err := StringToSignedWei(SignedWeiToString(wei, true, parentheses))
+		assert.Nil(t, err, "Failed to convert string to Wei")
+		assert.Equal(t, wei, result, "Did not receive expected result")
+	}
+}
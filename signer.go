@@ -17,6 +17,7 @@ package etherutils
 import (
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -24,21 +25,53 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
-// KeySigner generates a signer using a private key
+// Signer signs on behalf of a single account
+type Signer interface {
+	// Address returns the address of the account
+	Address() common.Address
+	// SignTx signs a transaction for the given chain
+	SignTx(chainID *big.Int, tx *types.Transaction) (*types.Transaction, error)
+	// SignHash signs a 32-byte hash, returning a 65-byte [R || S || V]
+	// signature where V is 0 or 1
+	SignHash(hash []byte) ([]byte, error)
+	// SignTypedData signs EIP-712 typed data, returning a 65-byte
+	// [R || S || V] signature where V is 27 or 28
+	SignTypedData(data *apitypes.TypedData) ([]byte, error)
+}
+
+// BindSigner generates a bind signer function from a Signer, for use in
+// bind.TransactOpts
+func BindSigner(chainID *big.Int, s Signer) (signerfn bind.SignerFn) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != s.Address() {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		if _, err := TransactionSigner(chainID, tx); err != nil {
+			return nil, err
+		}
+		return s.SignTx(chainID, tx)
+	}
+	return
+}
+
+// KeySigner generates a signer using a private key.
+// The signer is chosen to suit the type of each transaction, so legacy,
+// EIP-2930 access list, EIP-1559 dynamic fee and EIP-4844 blob transactions
+// can all be signed
 func KeySigner(chainID *big.Int, key *ecdsa.PrivateKey) (signerfn bind.SignerFn) {
-	signerfn = func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 		keyAddr := crypto.PubkeyToAddress(key.PublicKey)
 		if address != keyAddr {
 			return nil, errors.New("not authorized to sign this account")
 		}
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), key)
-		// signature, err := crypto.Sign(signer.Hash(tx).Bytes(), key)
-		// if err != nil {
-		// return nil, err
-		// }
-		// return tx.WithSignature(signer, signature)
+		txSigner, err := TransactionSigner(chainID, tx)
+		if err != nil {
+			return nil, err
+		}
+		return types.SignTx(tx, txSigner, key)
 	}
 
 	return
@@ -46,15 +79,44 @@ func KeySigner(chainID *big.Int, key *ecdsa.PrivateKey) (signerfn bind.SignerFn)
 
 // AccountSigner generates a signer using an account
 func AccountSigner(chainID *big.Int, wallet *accounts.Wallet, account *accounts.Account, passphrase string) (signerfn bind.SignerFn) {
-	signerfn = func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 		if address != account.Address {
 			return nil, errors.New("not authorized to sign this account")
 		}
+		if _, err := TransactionSigner(chainID, tx); err != nil {
+			return nil, err
+		}
 		return (*wallet).SignTxWithPassphrase(*account, passphrase, tx, chainID)
 	}
 	return
 }
 
+// TransactionSigner selects the signer for a transaction on a given chain
+// based on the transaction's type.  It returns an error if the transaction
+// is for a different chain
+func TransactionSigner(chainID *big.Int, tx *types.Transaction) (types.Signer, error) {
+	if chainID == nil {
+		return nil, errors.New("no chain ID supplied")
+	}
+	// Legacy transactions only carry a chain ID once signed
+	if tx.Type() != types.LegacyTxType && tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("transaction chain ID %v does not match chain ID %v", tx.ChainId(), chainID)
+	}
+
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return types.NewEIP155Signer(chainID), nil
+	case types.AccessListTxType:
+		return types.NewEIP2930Signer(chainID), nil
+	case types.DynamicFeeTxType:
+		return types.NewLondonSigner(chainID), nil
+	case types.BlobTxType:
+		return types.NewCancunSigner(chainID), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+}
+
 //func KeySigner() (signerfn bind.SignerFn) {
 //	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
 //
@@ -0,0 +1,88 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+var testAddress = crypto.PubkeyToAddress(testKey.PublicKey)
+var testRecipient = common.HexToAddress("0x90f8bf6a479f320ead074411a4b0e7944ea8c9c1")
+var testChainID = big.NewInt(1)
+
+func testDynamicFeeTx(chainID *big.Int) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(2000000000),
+		GasFeeCap: big.NewInt(100000000000),
+		Gas:       21000,
+		To:        &testRecipient,
+		Value:     big.NewInt(1),
+	})
+}
+
+func TestKeySignerLegacy(t *testing.T) {
+	tx := types.NewTransaction(0, testRecipient, big.NewInt(1), 21000, big.NewInt(1000000000), nil)
+	signed, err := KeySigner(testChainID, testKey)(testAddress, tx)
+	assert.Nil(t, err, "Failed to sign legacy transaction")
+	assert.Equal(t, testChainID, signed.ChainId(), "Did not receive expected chain ID")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+}
+
+func TestKeySignerAccessList(t *testing.T) {
+	tx := types.NewTx(&types.AccessListTx{
+		ChainID:  testChainID,
+		Nonce:    0,
+		GasPrice: big.NewInt(1000000000),
+		Gas:      21000,
+		To:       &testRecipient,
+		Value:    big.NewInt(1),
+	})
+	signed, err := KeySigner(testChainID, testKey)(testAddress, tx)
+	assert.Nil(t, err, "Failed to sign access list transaction")
+	assert.Equal(t, uint8(types.AccessListTxType), signed.Type(), "Did not receive expected type")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+}
+
+func TestKeySignerDynamicFee(t *testing.T) {
+	signed, err := KeySigner(testChainID, testKey)(testAddress, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign dynamic fee transaction")
+	assert.Equal(t, uint8(types.DynamicFeeTxType), signed.Type(), "Did not receive expected type")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+}
+
+func TestKeySignerTransactionChainIDMismatch(t *testing.T) {
+	_, err := KeySigner(testChainID, testKey)(testAddress, testDynamicFeeTx(big.NewInt(5)))
+	assert.NotNil(t, err, "Signed transaction for another chain")
+}
+
+func TestKeySignerWrongAddress(t *testing.T) {
+	_, err := KeySigner(testChainID, testKey)(testRecipient, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed transaction for another account")
+}
@@ -0,0 +1,243 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// standInSigner is a minimal Clef-compatible signer for testing
+type standInSigner struct {
+	key *ecdsa.PrivateKey
+	// tamper alters transactions before signing them
+	tamper bool
+}
+
+func (s *standInSigner) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+func (s *standInSigner) SignTransaction(args externalTransactionArgs) (*externalTransactionResult, error) {
+	if args.From.Address() != crypto.PubkeyToAddress(s.key.PublicKey) {
+		return nil, errors.New("unknown account")
+	}
+	if s.tamper {
+		args.Value = hexutil.Big(*new(big.Int).Add(args.Value.ToInt(), big.NewInt(1)))
+	}
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	var to *common.Address
+	if args.To != nil {
+		address := args.To.Address()
+		to = &address
+	}
+	var tx *types.Transaction
+	if args.MaxFeePerGas != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        to,
+			Value:     args.Value.ToInt(),
+			Data:      data,
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       to,
+			Value:    args.Value.ToInt(),
+			Data:     data,
+		})
+	}
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &externalTransactionResult{Raw: raw, Tx: signed}, nil
+}
+
+func (s *standInSigner) SignTypedData(address common.MixedcaseAddress, data apitypes.TypedData) (hexutil.Bytes, error) {
+	return NewMemorySigner(s.key).SignTypedData(&data)
+}
+
+func newStandInSigner(t *testing.T, tamper bool) *ExternalSigner {
+	server := rpc.NewServer()
+	err := server.RegisterName("account", &standInSigner{key: testKey, tamper: tamper})
+	assert.Nil(t, err, "Failed to register stand-in signer")
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	signer, err := NewExternalSigner(httpServer.URL, testAddress, 0)
+	assert.Nil(t, err, "Failed to connect to stand-in signer")
+	t.Cleanup(signer.Close)
+	return signer
+}
+
+func newTestKeystoreSigner(t *testing.T) *KeystoreSigner {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err := ks.ImportECDSA(testKey, "secret")
+	assert.Nil(t, err, "Failed to import key")
+	signer, err := NewKeystoreSigner(ks, testAddress, "secret")
+	assert.Nil(t, err, "Failed to create keystore signer")
+	return signer
+}
+
+var testTypedData = &apitypes.TypedData{
+	Types: apitypes.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+		},
+		"Order": {
+			{Name: "amount", Type: "uint256"},
+		},
+	},
+	PrimaryType: "Order",
+	Domain: apitypes.TypedDataDomain{
+		Name:    "Test",
+		ChainId: (*math.HexOrDecimal256)(big.NewInt(1)),
+	},
+	Message: apitypes.TypedDataMessage{
+		"amount": "1000",
+	},
+}
+
+func testSigner(t *testing.T, signer Signer) {
+	assert.Equal(t, testAddress, signer.Address(), "Did not receive expected address")
+
+	signed, err := signer.SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign transaction")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+
+	_, err = signer.SignTx(testChainID, testDynamicFeeTx(big.NewInt(5)))
+	assert.NotNil(t, err, "Signed transaction for another chain")
+
+	signature, err := signer.SignTypedData(testTypedData)
+	assert.Nil(t, err, "Failed to sign typed data")
+	assert.Equal(t, 65, len(signature), "Did not receive expected signature length")
+	assert.True(t, signature[64] == 27 || signature[64] == 28, "Did not receive expected recovery ID")
+	hash, _, err := apitypes.TypedDataAndHash(*testTypedData)
+	assert.Nil(t, err, "Failed to hash typed data")
+	recoverable := common.CopyBytes(signature)
+	recoverable[64] -= 27
+	pubKey, err := crypto.SigToPub(hash, recoverable)
+	assert.Nil(t, err, "Failed to recover public key")
+	assert.Equal(t, testAddress, crypto.PubkeyToAddress(*pubKey), "Did not receive expected signer")
+}
+
+func TestMemorySigner(t *testing.T) {
+	testSigner(t, NewMemorySigner(testKey))
+}
+
+func TestMemorySignerSignHash(t *testing.T) {
+	hash := crypto.Keccak256([]byte("test"))
+	signature, err := NewMemorySigner(testKey).SignHash(hash)
+	assert.Nil(t, err, "Failed to sign hash")
+	pubKey, err := crypto.SigToPub(hash, signature)
+	assert.Nil(t, err, "Failed to recover public key")
+	assert.Equal(t, testAddress, crypto.PubkeyToAddress(*pubKey), "Did not receive expected signer")
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	testSigner(t, newTestKeystoreSigner(t))
+}
+
+func TestKeystoreSignerSignHash(t *testing.T) {
+	hash := crypto.Keccak256([]byte("test"))
+	signature, err := newTestKeystoreSigner(t).SignHash(hash)
+	assert.Nil(t, err, "Failed to sign hash")
+	expected, _ := NewMemorySigner(testKey).SignHash(hash)
+	assert.Equal(t, expected, signature, "Did not receive expected signature")
+}
+
+func TestKeystoreSignerUnknownAccount(t *testing.T) {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err := NewKeystoreSigner(ks, testAddress, "secret")
+	assert.NotNil(t, err, "Created signer for unknown account")
+}
+
+func TestKeystoreSignerBadPassphrase(t *testing.T) {
+	signer := newTestKeystoreSigner(t)
+	signer.passphrase = "wrong"
+	_, err := signer.SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed with bad passphrase")
+}
+
+func TestExternalSigner(t *testing.T) {
+	testSigner(t, newStandInSigner(t, false))
+}
+
+func TestExternalSignerLegacy(t *testing.T) {
+	tx := types.NewTransaction(0, testRecipient, big.NewInt(1), 21000, big.NewInt(1000000000), []byte{0x01})
+	signed, err := newStandInSigner(t, false).SignTx(testChainID, tx)
+	assert.Nil(t, err, "Failed to sign legacy transaction")
+	assert.Equal(t, testChainID, signed.ChainId(), "Did not receive expected chain ID")
+	assert.Equal(t, []byte{0x01}, signed.Data(), "Did not receive expected data")
+}
+
+func TestExternalSignerAccounts(t *testing.T) {
+	accounts, err := newStandInSigner(t, false).Accounts()
+	assert.Nil(t, err, "Failed to list accounts")
+	assert.Equal(t, []common.Address{testAddress}, accounts, "Did not receive expected accounts")
+}
+
+func TestExternalSignerTampered(t *testing.T) {
+	_, err := newStandInSigner(t, true).SignTx(testChainID, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Accepted tampered transaction")
+}
+
+func TestExternalSignerSignHash(t *testing.T) {
+	_, err := newStandInSigner(t, false).SignHash(crypto.Keccak256([]byte("test")))
+	assert.NotNil(t, err, "Signed raw hash")
+}
+
+func TestBindSigner(t *testing.T) {
+	signerfn := BindSigner(testChainID, NewMemorySigner(testKey))
+	signed, err := signerfn(testAddress, testDynamicFeeTx(testChainID))
+	assert.Nil(t, err, "Failed to sign transaction")
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	assert.Nil(t, err, "Failed to recover sender")
+	assert.Equal(t, testAddress, sender, "Did not receive expected sender")
+
+	_, err = signerfn(testRecipient, testDynamicFeeTx(testChainID))
+	assert.NotNil(t, err, "Signed transaction for another account")
+}
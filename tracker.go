@@ -0,0 +1,390 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransactionStatus is the state of a tracked transaction
+type TransactionStatus string
+
+const (
+	// StatusPending is a transaction that is known to the node but not mined
+	StatusPending TransactionStatus = "pending"
+	// StatusMined is a transaction that was mined and succeeded
+	StatusMined TransactionStatus = "mined"
+	// StatusFailed is a transaction that was mined and reverted
+	StatusFailed TransactionStatus = "failed"
+	// StatusReplaced is a transaction whose nonce was used by another
+	// transaction
+	StatusReplaced TransactionStatus = "replaced"
+	// StatusDropped is a transaction that the node no longer knows about and
+	// whose nonce is still unused
+	StatusDropped TransactionStatus = "dropped"
+)
+
+// Final returns true if the status can no longer change
+func (s TransactionStatus) Final() bool {
+	return s == StatusMined || s == StatusFailed || s == StatusReplaced
+}
+
+// TrackedTransaction is the record of a transaction held by a tracker
+type TrackedTransaction struct {
+	Hash        common.Hash       `json:"hash"`
+	ChainID     *big.Int          `json:"chainId"`
+	From        common.Address    `json:"from"`
+	To          *common.Address   `json:"to,omitempty"`
+	Nonce       uint64            `json:"nonce"`
+	Purpose     string            `json:"purpose,omitempty"`
+	GasPrice    *Wei              `json:"gasPrice,omitempty"`
+	GasTipCap   *Wei              `json:"gasTipCap,omitempty"`
+	GasFeeCap   *Wei              `json:"gasFeeCap,omitempty"`
+	Created     time.Time         `json:"created"`
+	Status      TransactionStatus `json:"status"`
+	BlockNumber *big.Int          `json:"blockNumber,omitempty"`
+	ReplacedBy  *common.Hash      `json:"replacedBy,omitempty"`
+}
+
+// TransactionStore holds tracked transactions
+type TransactionStore interface {
+	// Put adds a transaction, or updates it if it is already held
+	Put(tx *TrackedTransaction) error
+	// Get returns a transaction, or nil if it is not held
+	Get(hash common.Hash) (*TrackedTransaction, error)
+	// All returns all transactions in the order they were added
+	All() ([]*TrackedTransaction, error)
+}
+
+// FileTransactionStore holds tracked transactions in a local JSON file
+type FileTransactionStore struct {
+	mutex sync.Mutex
+	path  string
+	txs   []*TrackedTransaction
+}
+
+// NewFileTransactionStore creates a transaction store backed by the given
+// file, loading any transactions already in it
+func NewFileTransactionStore(path string) (*FileTransactionStore, error) {
+	store := &FileTransactionStore{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &store.txs); err != nil {
+		return nil, fmt.Errorf("invalid transaction store %s: %v", path, err)
+	}
+	return store, nil
+}
+
+// Put adds or updates a transaction and writes the file
+func (s *FileTransactionStore) Put(tx *TrackedTransaction) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	record := *tx
+	txs := make([]*TrackedTransaction, len(s.txs), len(s.txs)+1)
+	copy(txs, s.txs)
+	found := false
+	for i := range txs {
+		if txs[i].Hash == tx.Hash {
+			txs[i] = &record
+			found = true
+			break
+		}
+	}
+	if !found {
+		txs = append(txs, &record)
+	}
+	if err := s.write(txs); err != nil {
+		return err
+	}
+	s.txs = txs
+	return nil
+}
+
+// Get returns a transaction, or nil if it is not held
+func (s *FileTransactionStore) Get(hash common.Hash) (*TrackedTransaction, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, tx := range s.txs {
+		if tx.Hash == hash {
+			record := *tx
+			return &record, nil
+		}
+	}
+	return nil, nil
+}
+
+// All returns all transactions in the order they were added
+func (s *FileTransactionStore) All() ([]*TrackedTransaction, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	txs := make([]*TrackedTransaction, len(s.txs))
+	for i, tx := range s.txs {
+		record := *tx
+		txs[i] = &record
+	}
+	return txs, nil
+}
+
+// write replaces the file atomically, so that a crash cannot leave it
+// truncated
+func (s *FileTransactionStore) write(txs []*TrackedTransaction) error {
+	data, err := json.MarshalIndent(txs, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// TrackerBackend is the part of ethclient.Client needed to report the
+// status of tracked transactions
+type TrackerBackend interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// TransactionTracker records transactions and reports on their status
+// later, including after the program has restarted.  It records the
+// transactions it is given: those passed to Track, those signed through a
+// signer from BindSigner, such as the ENS sessions created with a tracker,
+// and replacements made with a tracker in their ReplacementOptions.  Other
+// transactions sent through the package, for example with Broadcast or
+// NonceManager.Transact, are only recorded if passed to Track or signed
+// through BindSigner
+type TransactionTracker struct {
+	store TransactionStore
+}
+
+// NewTransactionTracker creates a tracker that records transactions in the
+// given store
+func NewTransactionTracker(store TransactionStore) *TransactionTracker {
+	return &TransactionTracker{store: store}
+}
+
+// Track records a signed transaction with a description of its purpose,
+// e.g. "ENS SetResolver foo.eth"
+func (t *TransactionTracker) Track(chainID *big.Int, tx *types.Transaction, purpose string) (*TrackedTransaction, error) {
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	return t.track(chainID, from, tx, purpose)
+}
+
+func (t *TransactionTracker) track(chainID *big.Int, from common.Address, tx *types.Transaction, purpose string) (*TrackedTransaction, error) {
+	record := &TrackedTransaction{
+		Hash:    tx.Hash(),
+		ChainID: new(big.Int).Set(chainID),
+		From:    from,
+		To:      tx.To(),
+		Nonce:   tx.Nonce(),
+		Purpose: purpose,
+		Created: time.Now().UTC(),
+		Status:  StatusPending,
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		record.GasTipCap = NewWei(tx.GasTipCap())
+		record.GasFeeCap = NewWei(tx.GasFeeCap())
+	} else {
+		record.GasPrice = NewWei(tx.GasPrice())
+	}
+	if err := t.store.Put(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// BindSigner wraps a bind signer function, such as the Signer of a contract
+// session's TransactOpts, so that every transaction it signs is tracked
+// with the given purpose on the given chain.  If the tracker cannot record
+// the transaction the signed transaction is not released
+func (t *TransactionTracker) BindSigner(chainID *big.Int, signerfn bind.SignerFn, purpose string) bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if chainID == nil {
+			return nil, errors.New("no chain ID for tracked transaction")
+		}
+		signed, err := signerfn(address, tx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.track(chainID, address, signed, purpose); err != nil {
+			return nil, fmt.Errorf("failed to track transaction: %v", err)
+		}
+		return signed, nil
+	}
+}
+
+// Transactions returns all tracked transactions with their last known
+// status
+func (t *TransactionTracker) Transactions() ([]*TrackedTransaction, error) {
+	return t.store.All()
+}
+
+// Status updates the status of a tracked transaction from the node
+func (t *TransactionTracker) Status(ctx context.Context, backend TrackerBackend, hash common.Hash) (*TrackedTransaction, error) {
+	record, err := t.store.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, fmt.Errorf("transaction %s is not tracked", hash.Hex())
+	}
+	if record.Status.Final() {
+		return record, nil
+	}
+	if err = t.update(ctx, backend, record); err != nil {
+		return nil, err
+	}
+	if record.Status == StatusReplaced {
+		all, err := t.store.All()
+		if err != nil {
+			return nil, err
+		}
+		if err = t.linkReplacement(record, all); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+// Refresh updates the status of every tracked transaction on the backend's
+// chain whose status is not final, and returns all tracked transactions
+func (t *TransactionTracker) Refresh(ctx context.Context, backend TrackerBackend) ([]*TrackedTransaction, error) {
+	all, err := t.store.All()
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var replaced []*TrackedTransaction
+	for _, record := range all {
+		if record.ChainID.Cmp(chainID) != 0 || record.Status.Final() {
+			continue
+		}
+		if err := t.update(ctx, backend, record); err != nil {
+			return nil, err
+		}
+		if record.Status == StatusReplaced {
+			replaced = append(replaced, record)
+		}
+	}
+	// Replacements are usually tracked after the transactions they replace,
+	// so are only matched once every status is up to date
+	for _, record := range replaced {
+		if err := t.linkReplacement(record, all); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// update obtains the status of a transaction from the node and stores it if
+// it has changed
+func (t *TransactionTracker) update(ctx context.Context, backend TrackerBackend, record *TrackedTransaction) error {
+	status, blockNumber, err := transactionStatus(ctx, backend, record)
+	if err != nil {
+		return err
+	}
+	if status == record.Status {
+		return nil
+	}
+	record.Status = status
+	record.BlockNumber = blockNumber
+	return t.store.Put(record)
+}
+
+// linkReplacement finds the mined transaction that used the nonce of a
+// replaced transaction, if it is tracked
+func (t *TransactionTracker) linkReplacement(record *TrackedTransaction, all []*TrackedTransaction) error {
+	if record.ReplacedBy != nil {
+		return nil
+	}
+	for _, other := range all {
+		if other.Hash == record.Hash || other.From != record.From || other.Nonce != record.Nonce || other.ChainID.Cmp(record.ChainID) != 0 {
+			continue
+		}
+		if other.Status == StatusMined || other.Status == StatusFailed {
+			hash := other.Hash
+			record.ReplacedBy = &hash
+			return t.store.Put(record)
+		}
+	}
+	return nil
+}
+
+// transactionStatus obtains the status of a transaction from the node
+func transactionStatus(ctx context.Context, backend TrackerBackend, record *TrackedTransaction) (TransactionStatus, *big.Int, error) {
+	receipt, err := backend.TransactionReceipt(ctx, record.Hash)
+	if err == nil {
+		if receipt.Status == types.ReceiptStatusFailed {
+			return StatusFailed, receipt.BlockNumber, nil
+		}
+		return StatusMined, receipt.BlockNumber, nil
+	}
+	if err != ethereum.NotFound {
+		return "", nil, err
+	}
+
+	_, _, err = backend.TransactionByHash(ctx, record.Hash)
+	if err == nil {
+		return StatusPending, nil, nil
+	}
+	if err != ethereum.NotFound {
+		return "", nil, err
+	}
+
+	// Unknown to the node; find out if its nonce has been used
+	nonce, err := backend.NonceAt(ctx, record.From, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	if nonce > record.Nonce {
+		return StatusReplaced, nil, nil
+	}
+	return StatusDropped, nil, nil
+}
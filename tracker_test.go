@@ -0,0 +1,187 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var trackerChainID = big.NewInt(1337)
+
+// testTrackerBackend reports the state of transactions set by the test
+type testTrackerBackend struct {
+	receipts map[common.Hash]*types.Receipt
+	pending  map[common.Hash]bool
+	nonce    uint64
+}
+
+func newTestTrackerBackend() *testTrackerBackend {
+	return &testTrackerBackend{
+		receipts: make(map[common.Hash]*types.Receipt),
+		pending:  make(map[common.Hash]bool),
+	}
+}
+
+func (b *testTrackerBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	return trackerChainID, nil
+}
+
+func (b *testTrackerBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, exists := b.receipts[hash]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (b *testTrackerBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if !b.pending[hash] {
+		return nil, false, ethereum.NotFound
+	}
+	return nil, true, nil
+}
+
+func (b *testTrackerBackend) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return b.nonce, nil
+}
+
+// signTracked signs a transaction with the test key
+func signTracked(t *testing.T, nonce uint64, tip int64) *types.Transaction {
+	tx, err := NewMemorySigner(testKey).SignTx(trackerChainID, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   trackerChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(100000000000),
+		Gas:       21000,
+		To:        &testRecipient,
+		Value:     big.NewInt(1),
+	}))
+	assert.Nil(t, err, "Failed to sign transaction")
+	return tx
+}
+
+func newTestTracker(t *testing.T) (*TransactionTracker, string) {
+	path := filepath.Join(t.TempDir(), "transactions.json")
+	store, err := NewFileTransactionStore(path)
+	assert.Nil(t, err, "Failed to create store")
+	return NewTransactionTracker(store), path
+}
+
+func TestTrackTransaction(t *testing.T) {
+	tracker, path := newTestTracker(t)
+	tx := signTracked(t, 3, 1000000000)
+	record, err := tracker.Track(trackerChainID, tx, "ENS SetResolver foo.eth")
+	assert.Nil(t, err, "Failed to track transaction")
+	assert.Equal(t, testAddress, record.From, "Did not receive expected sender")
+	assert.Equal(t, StatusPending, record.Status, "Did not receive expected status")
+
+	// Transactions are still known after a restart
+	store, err := NewFileTransactionStore(path)
+	assert.Nil(t, err, "Failed to load store")
+	txs, err := NewTransactionTracker(store).Transactions()
+	assert.Nil(t, err, "Failed to obtain transactions")
+	assert.Equal(t, 1, len(txs), "Did not receive expected number of transactions")
+	assert.Equal(t, tx.Hash(), txs[0].Hash, "Did not receive expected hash")
+	assert.Equal(t, uint64(3), txs[0].Nonce, "Did not receive expected nonce")
+	assert.Equal(t, "ENS SetResolver foo.eth", txs[0].Purpose, "Did not receive expected purpose")
+	assert.Equal(t, big.NewInt(1000000000), txs[0].GasTipCap.Int(), "Did not receive expected tip")
+	assert.Equal(t, big.NewInt(100000000000), txs[0].GasFeeCap.Int(), "Did not receive expected fee cap")
+	assert.Nil(t, txs[0].GasPrice, "Received unexpected gas price")
+	assert.Equal(t, trackerChainID, txs[0].ChainID, "Did not receive expected chain ID")
+}
+
+func TestTrackerBindSigner(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+	signerfn := tracker.BindSigner(trackerChainID, BindSigner(trackerChainID, NewMemorySigner(testKey)), "ENS SetAddr foo.eth")
+	tx := types.NewTransaction(0, testRecipient, big.NewInt(0), 21000, big.NewInt(20000000000), nil)
+	signed, err := signerfn(testAddress, tx)
+	assert.Nil(t, err, "Failed to sign transaction")
+
+	txs, _ := tracker.Transactions()
+	assert.Equal(t, 1, len(txs), "Did not receive expected number of transactions")
+	assert.Equal(t, signed.Hash(), txs[0].Hash, "Did not receive expected hash")
+	assert.Equal(t, "ENS SetAddr foo.eth", txs[0].Purpose, "Did not receive expected purpose")
+	assert.Equal(t, big.NewInt(20000000000), txs[0].GasPrice.Int(), "Did not receive expected gas price")
+	assert.Equal(t, trackerChainID, txs[0].ChainID, "Did not receive expected chain ID")
+
+	// Without a chain ID nothing is signed or tracked
+	_, err = tracker.BindSigner(nil, BindSigner(trackerChainID, NewMemorySigner(testKey)), "")(testAddress, tx)
+	assert.NotNil(t, err, "Did not receive error")
+	txs, _ = tracker.Transactions()
+	assert.Equal(t, 1, len(txs), "Did not receive expected number of transactions")
+}
+
+func TestTrackerRefresh(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+	mined := signTracked(t, 0, 1000000000)
+	failed := signTracked(t, 1, 1000000000)
+	replaced := signTracked(t, 2, 1000000000)
+	replacement := signTracked(t, 2, 2000000000)
+	pending := signTracked(t, 3, 1000000000)
+	dropped := signTracked(t, 4, 1000000000)
+	for _, tx := range []*types.Transaction{mined, failed, replaced, replacement, pending, dropped} {
+		_, err := tracker.Track(trackerChainID, tx, "")
+		assert.Nil(t, err, "Failed to track transaction")
+	}
+
+	backend := newTestTrackerBackend()
+	backend.nonce = 3
+	backend.receipts[mined.Hash()] = &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10)}
+	backend.receipts[failed.Hash()] = &types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(11)}
+	backend.receipts[replacement.Hash()] = &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(12)}
+	backend.pending[pending.Hash()] = true
+
+	txs, err := tracker.Refresh(context.Background(), backend)
+	assert.Nil(t, err, "Failed to refresh")
+	statuses := make(map[common.Hash]*TrackedTransaction)
+	for _, tx := range txs {
+		statuses[tx.Hash] = tx
+	}
+	assert.Equal(t, StatusMined, statuses[mined.Hash()].Status, "Did not receive expected status")
+	assert.Equal(t, big.NewInt(10), statuses[mined.Hash()].BlockNumber, "Did not receive expected block number")
+	assert.Equal(t, StatusFailed, statuses[failed.Hash()].Status, "Did not receive expected status")
+	assert.Equal(t, StatusReplaced, statuses[replaced.Hash()].Status, "Did not receive expected status")
+	assert.Equal(t, replacement.Hash(), *statuses[replaced.Hash()].ReplacedBy, "Did not receive expected replacement")
+	assert.Equal(t, StatusMined, statuses[replacement.Hash()].Status, "Did not receive expected status")
+	assert.Equal(t, StatusPending, statuses[pending.Hash()].Status, "Did not receive expected status")
+	assert.Equal(t, StatusDropped, statuses[dropped.Hash()].Status, "Did not receive expected status")
+
+	// Updates are stored
+	txs, _ = tracker.Transactions()
+	assert.Equal(t, StatusReplaced, txs[2].Status, "Status not stored")
+
+	// Final statuses are not checked again
+	delete(backend.receipts, mined.Hash())
+	record, err := tracker.Status(context.Background(), backend, mined.Hash())
+	assert.Nil(t, err, "Failed to obtain status")
+	assert.Equal(t, StatusMined, record.Status, "Did not receive expected status")
+
+	// Dropped transactions can reappear
+	backend.pending[dropped.Hash()] = true
+	record, err = tracker.Status(context.Background(), backend, dropped.Hash())
+	assert.Nil(t, err, "Failed to obtain status")
+	assert.Equal(t, StatusPending, record.Status, "Did not receive expected status")
+
+	_, err = tracker.Status(context.Background(), backend, common.HexToHash("0x01"))
+	assert.NotNil(t, err, "Did not receive error")
+}
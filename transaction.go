@@ -14,31 +14,141 @@
 
 package etherutils
 
-// Watch for a transaction to be mined.
-// If the transaction is mined this will return true
-// func Mined(client *ethclient.Client, tx *types.Transaction) (mined bool, err error) {
-// 	type ContextKey string
-// 	key := ContextKey("hash")
-//
-// 	headChan := make(chan *types.Header)
-// 	// d := time.Now().Add(60 * time.Second)
-// 	// ctx, cancel := context.WithDeadline(context.Background(), d)
-// 	// defer cancel()
-// 	ctx := context.WithValue(context.Background(), key, tx.Hash().Hex())
-//
-// 	_, err = client.SubscribeNewHead(ctx, headChan)
-// 	if err != nil {
-// 		return false, errors.New("Failed to listen for confirmation")
-// 	}
-// 	pending := true
-// 	for pending {
-// 		_ = <-headChan
-// 		_, pending, err = client.TransactionByHash(ctx, tx.Hash())
-// 		if err != nil {
-// 			// An error of some sort occurred
-// 			return false, err
-// 		}
-// 	}
-//
-// 	return true, nil
-// }
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrTransactionReverted is returned when a confirmed transaction failed
+var ErrTransactionReverted = errors.New("transaction reverted")
+
+// DefaultPollInterval is how often to check for confirmations when new
+// heads cannot be subscribed to
+var DefaultPollInterval = 4 * time.Second
+
+// ConfirmationBackend is the part of ethclient.Client needed to watch for
+// confirmations
+type ConfirmationBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// ConfirmationOptions configure how WaitForConfirmations watches a
+// transaction
+type ConfirmationOptions struct {
+	// Confirmations is the number of blocks, including the block containing
+	// the transaction, that must be on the chain.  Defaults to 1
+	Confirmations uint64
+	// PollInterval is how often to check when new heads cannot be
+	// subscribed to.  Defaults to DefaultPollInterval
+	PollInterval time.Duration
+}
+
+// WaitForConfirmations waits for a transaction to be included in the chain
+// with the required number of confirmations, returning its receipt.  The
+// block containing the transaction is re-checked before returning, so a
+// transaction that is reorganised out of the chain continues to be waited
+// for.  New heads are used to trigger checks where the backend supports
+// subscriptions, otherwise the backend is polled.  If the transaction
+// reverted the receipt is returned along with ErrTransactionReverted
+func WaitForConfirmations(ctx context.Context, backend ConfirmationBackend, hash common.Hash, opts *ConfirmationOptions) (*types.Receipt, error) {
+	confirmations := uint64(1)
+	pollInterval := DefaultPollInterval
+	if opts != nil {
+		if opts.Confirmations > 0 {
+			confirmations = opts.Confirmations
+		}
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+	}
+
+	heads := make(chan *types.Header, 16)
+	var subErr <-chan error
+	var poll <-chan time.Time
+	sub, err := backend.SubscribeNewHead(ctx, heads)
+	if err == nil {
+		defer sub.Unsubscribe()
+		subErr = sub.Err()
+	} else {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		receipt, err := confirmedReceipt(ctx, backend, hash, confirmations)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			if receipt.Status == types.ReceiptStatusFailed {
+				return receipt, ErrTransactionReverted
+			}
+			return receipt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-heads:
+		case <-poll:
+		case <-subErr:
+			// Subscription has gone away; fall back to polling
+			subErr = nil
+			ticker := time.NewTicker(pollInterval)
+			defer ticker.Stop()
+			poll = ticker.C
+		}
+	}
+}
+
+// Mined waits for a transaction to be included in the chain, returning its
+// receipt
+func Mined(ctx context.Context, backend ConfirmationBackend, tx *types.Transaction) (*types.Receipt, error) {
+	return WaitForConfirmations(ctx, backend, tx.Hash(), nil)
+}
+
+// confirmedReceipt returns the receipt for a transaction if it has the
+// required number of confirmations on the current chain, otherwise nil
+func confirmedReceipt(ctx context.Context, backend ConfirmationBackend, hash common.Hash, confirmations uint64) (*types.Receipt, error) {
+	receipt, err := backend.TransactionReceipt(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.Number.Cmp(receipt.BlockNumber) < 0 {
+		return nil, nil
+	}
+	depth := new(big.Int).Sub(head.Number, receipt.BlockNumber)
+	if depth.Uint64()+1 < confirmations {
+		return nil, nil
+	}
+
+	// Ensure that the block containing the transaction is still canonical
+	header, err := backend.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header.Hash() != receipt.BlockHash {
+		return nil, nil
+	}
+	return receipt, nil
+}
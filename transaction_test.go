@@ -0,0 +1,239 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+var simulatedChainID = big.NewInt(1337)
+
+func newSimulatedBackend(t *testing.T) *simulated.Backend {
+	balance, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		testAddress: {Balance: balance},
+	})
+	t.Cleanup(func() { backend.Close() })
+	return backend
+}
+
+// sendSimulated sends a transaction from the test account
+func sendSimulated(t *testing.T, backend *simulated.Backend, nonce uint64, to *common.Address, data []byte) *types.Transaction {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   simulatedChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(100000000000),
+		Gas:       100000,
+		To:        to,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
+	signed, err := NewMemorySigner(testKey).SignTx(simulatedChainID, tx)
+	assert.Nil(t, err, "Failed to sign transaction")
+	assert.Nil(t, backend.Client().SendTransaction(context.Background(), signed), "Failed to send transaction")
+	return signed
+}
+
+// commitUntil commits blocks until the channel is closed
+func commitUntil(backend *simulated.Backend, done chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(20 * time.Millisecond):
+			backend.Commit()
+		}
+	}
+}
+
+func TestWaitForConfirmations(t *testing.T) {
+	backend := newSimulatedBackend(t)
+	tx := sendSimulated(t, backend, 0, &testRecipient, nil)
+
+	done := make(chan struct{})
+	defer close(done)
+	go commitUntil(backend, done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, err := WaitForConfirmations(ctx, backend.Client(), tx.Hash(), &ConfirmationOptions{Confirmations: 3})
+	assert.Nil(t, err, "Failed to wait for confirmations")
+	assert.Equal(t, tx.Hash(), receipt.TxHash, "Did not receive expected receipt")
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "Did not receive expected status")
+
+	head, err := backend.Client().HeaderByNumber(ctx, nil)
+	assert.Nil(t, err, "Failed to obtain head")
+	assert.True(t, head.Number.Uint64() >= receipt.BlockNumber.Uint64()+2, "Returned before required confirmations")
+}
+
+func TestWaitForConfirmationsReverted(t *testing.T) {
+	backend := newSimulatedBackend(t)
+	// Contract creation whose init code is PUSH1 0 PUSH1 0 REVERT
+	tx := sendSimulated(t, backend, 0, nil, []byte{0x60, 0x00, 0x60, 0x00, 0xfd})
+	backend.Commit()
+
+	receipt, err := Mined(context.Background(), backend.Client(), tx)
+	assert.Equal(t, ErrTransactionReverted, err, "Did not receive expected error")
+	assert.Equal(t, types.ReceiptStatusFailed, receipt.Status, "Did not receive expected status")
+}
+
+func TestWaitForConfirmationsCancelled(t *testing.T) {
+	backend := newSimulatedBackend(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := WaitForConfirmations(ctx, backend.Client(), common.HexToHash("0x01"), nil)
+	assert.Equal(t, context.DeadlineExceeded, err, "Did not receive expected error")
+}
+
+// reorgBackend is a polling-only backend whose chain can be rewritten
+type reorgBackend struct {
+	mutex   sync.Mutex
+	headers map[uint64]*types.Header
+	head    uint64
+	receipt *types.Receipt
+	checks  int
+}
+
+func newReorgBackend() *reorgBackend {
+	return &reorgBackend{headers: make(map[uint64]*types.Header)}
+}
+
+// setBlock places a block on the chain, distinguished by its extra data
+func (b *reorgBackend) setBlock(number uint64, fork byte) *types.Header {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	header := &types.Header{Number: new(big.Int).SetUint64(number), Extra: []byte{fork}}
+	b.headers[number] = header
+	if number > b.head {
+		b.head = number
+	}
+	return header
+}
+
+func (b *reorgBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if number == nil {
+		return b.headers[b.head], nil
+	}
+	header, exists := b.headers[number.Uint64()]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+func (b *reorgBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.checks++
+	if b.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return b.receipt, nil
+}
+
+func (b *reorgBackend) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return nil, errors.New("notifications not supported")
+}
+
+func TestWaitForConfirmationsReorg(t *testing.T) {
+	backend := newReorgBackend()
+	backend.setBlock(1, 0)
+	orphaned := backend.setBlock(2, 0)
+	backend.setBlock(3, 0)
+	// Receipt refers to a block that has been replaced on the canonical chain
+	backend.setBlock(2, 1)
+	backend.receipt = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(2),
+		BlockHash:   orphaned.Hash(),
+	}
+
+	result := make(chan *types.Receipt)
+	go func() {
+		receipt, _ := WaitForConfirmations(context.Background(), backend, common.HexToHash("0x01"), &ConfirmationOptions{Confirmations: 2, PollInterval: 10 * time.Millisecond})
+		result <- receipt
+	}()
+
+	select {
+	case <-result:
+		t.Fatal("Accepted receipt from orphaned block")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Transaction is re-included in the canonical chain
+	backend.mutex.Lock()
+	canonical := backend.headers[2]
+	backend.receipt = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(2),
+		BlockHash:   canonical.Hash(),
+	}
+	backend.mutex.Unlock()
+
+	select {
+	case receipt := <-result:
+		assert.Equal(t, canonical.Hash(), receipt.BlockHash, "Did not receive expected block hash")
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive receipt after re-inclusion")
+	}
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	assert.True(t, backend.checks > 2, "Did not poll")
+}
+
+func TestWaitForConfirmationsPollingDepth(t *testing.T) {
+	backend := newReorgBackend()
+	block := backend.setBlock(1, 0)
+	backend.receipt = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(1),
+		BlockHash:   block.Hash(),
+	}
+
+	result := make(chan *types.Receipt)
+	go func() {
+		receipt, _ := WaitForConfirmations(context.Background(), backend, common.HexToHash("0x01"), &ConfirmationOptions{Confirmations: 3, PollInterval: 10 * time.Millisecond})
+		result <- receipt
+	}()
+
+	backend.setBlock(2, 0)
+	select {
+	case <-result:
+		t.Fatal("Returned before required confirmations")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	backend.setBlock(3, 0)
+	select {
+	case receipt := <-result:
+		assert.Equal(t, block.Hash(), receipt.BlockHash, "Did not receive expected block hash")
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive receipt after confirmations")
+	}
+}
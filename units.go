@@ -0,0 +1,186 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Unit is a named unit of value
+type Unit struct {
+	// Name is the display name of the unit, e.g. "Ether"
+	Name string
+	// Decimals is the number of decimal places between Wei and the unit,
+	// so the unit is worth 10^Decimals Wei
+	Decimals int
+	// Aliases are alternative names for the unit, e.g. "finney"
+	Aliases []string
+}
+
+// Multiplier returns the number of Wei in one of the unit
+func (u *Unit) Multiplier() *big.Int {
+	return new(big.Int).Set(pow10(u.Decimals))
+}
+
+// UnitRegistry holds a set of units that can be looked up by name or alias.
+// Names are case-insensitive
+type UnitRegistry struct {
+	mutex sync.RWMutex
+	units map[string]*Unit
+}
+
+// NewUnitRegistry creates an empty unit registry
+func NewUnitRegistry() *UnitRegistry {
+	return &UnitRegistry{
+		units: make(map[string]*Unit),
+	}
+}
+
+// Register adds a unit to the registry.  Neither the unit's name nor any of
+// its aliases can already be registered
+func (r *UnitRegistry) Register(unit *Unit) error {
+	if unit.Decimals < 0 {
+		return fmt.Errorf("Invalid number of decimals %d for unit %s", unit.Decimals, unit.Name)
+	}
+	keys := make([]string, 0, len(unit.Aliases)+1)
+	for _, name := range append([]string{unit.Name}, unit.Aliases...) {
+		if err := validUnitName(name); err != nil {
+			return err
+		}
+		key := strings.ToLower(name)
+		duplicate := false
+		for _, existing := range keys {
+			if existing == key {
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			keys = append(keys, key)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, key := range keys {
+		if _, exists := r.units[key]; exists {
+			return fmt.Errorf("Unit %s already registered", key)
+		}
+	}
+	for _, key := range keys {
+		r.units[key] = unit
+	}
+	return nil
+}
+
+// Lookup obtains a unit given its name or one of its aliases.  An empty name
+// is taken to be Wei
+func (r *UnitRegistry) Lookup(name string) (*Unit, error) {
+	if name == "" {
+		name = "wei"
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var unit *Unit
+	var exists bool
+	var buf [32]byte
+	if key, ok := appendLowerASCII(buf[:0], name); ok {
+		// Indexing with the converted bytes does not allocate
+		unit, exists = r.units[string(key)]
+	} else {
+		unit, exists = r.units[strings.ToLower(name)]
+	}
+	if !exists {
+		return nil, fmt.Errorf("Unknown unit %s", name)
+	}
+	return unit, nil
+}
+
+// appendLowerASCII appends the lower-case form of an ASCII name to dst.  It
+// returns false if the name is not ASCII or does not fit in dst's capacity
+func appendLowerASCII(dst []byte, name string) ([]byte, bool) {
+	if len(name) > cap(dst)-len(dst) {
+		return dst, false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c >= utf8.RuneSelf {
+			return dst, false
+		}
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst, true
+}
+
+// validUnitName ensures that a unit name can be parsed by StringToWei; it
+// must start with a letter and contain only letters, digits, '-' and '_'
+func validUnitName(name string) error {
+	if name == "" {
+		return errors.New("Unit name cannot be empty")
+	}
+	for i, c := range name {
+		if isUnitLetter(c) {
+			continue
+		}
+		if i > 0 && ((c >= '0' && c <= '9') || c == '-' || c == '_') {
+			continue
+		}
+		return fmt.Errorf("Invalid unit name %s", name)
+	}
+	return nil
+}
+
+func isUnitLetter(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// DefaultUnits is the registry used by StringToWei, UnitToMultiplier and
+// WeiFormatter.  It contains the standard Ethereum units
+var DefaultUnits = NewUnitRegistry()
+
+func init() {
+	standard := []*Unit{
+		{Name: "Wei", Decimals: 0},
+		{Name: "KWei", Decimals: 3, Aliases: []string{"ada", "kilowei"}},
+		{Name: "MWei", Decimals: 6, Aliases: []string{"babbage", "megawei"}},
+		{Name: "GWei", Decimals: 9, Aliases: []string{"shannon", "gigawei"}},
+		// "szazbo" is a misspelling retained for compatibility
+		{Name: "Microether", Decimals: 12, Aliases: []string{"szabo", "micro", "szazbo"}},
+		{Name: "Milliether", Decimals: 15, Aliases: []string{"finney", "milli"}},
+		{Name: "Ether", Decimals: 18},
+		{Name: "Kiloether", Decimals: 21, Aliases: []string{"einstein", "kilo"}},
+		{Name: "Megaether", Decimals: 24, Aliases: []string{"mega"}},
+		{Name: "Gigaether", Decimals: 27, Aliases: []string{"giga"}},
+		{Name: "Teraether", Decimals: 30, Aliases: []string{"tera"}},
+	}
+	for _, unit := range standard {
+		if err := DefaultUnits.Register(unit); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterUnit adds a unit to the default registry, after which it can be
+// used with StringToWei, e.g. RegisterUnit("MATIC", 18, "polygon")
+func RegisterUnit(name string, decimals int, aliases ...string) error {
+	return DefaultUnits.Register(&Unit{Name: name, Decimals: decimals, Aliases: aliases})
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToWeiWithSzaboUnit(t *testing.T) {
+	expected := big.NewInt(2000000000000)
+	result, err := StringToWei("2 szabo")
+	assert.Nil(t, err, "Failed to convert szabo string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+}
+
+func TestUnitRegistryLookup(t *testing.T) {
+	unit, err := DefaultUnits.Lookup("FINNEY")
+	assert.Nil(t, err, "Failed to look up unit")
+	assert.Equal(t, "Milliether", unit.Name, "Did not receive expected unit")
+	assert.Equal(t, big.NewInt(1000000000000000), unit.Multiplier(), "Did not receive expected multiplier")
+
+	unit, err = DefaultUnits.Lookup("")
+	assert.Nil(t, err, "Failed to look up empty unit")
+	assert.Equal(t, "Wei", unit.Name, "Did not receive expected unit")
+
+	_, err = DefaultUnits.Lookup("foo")
+	assert.NotNil(t, err, "Looked up unknown unit")
+}
+
+func TestUnitRegistryRegister(t *testing.T) {
+	registry := NewUnitRegistry()
+	err := registry.Register(&Unit{Name: "xDAI", Decimals: 18, Aliases: []string{"xdai", "dai-x"}})
+	assert.Nil(t, err, "Failed to register unit")
+	unit, err := registry.Lookup("XDAI")
+	assert.Nil(t, err, "Failed to look up unit")
+	assert.Equal(t, 18, unit.Decimals, "Did not receive expected unit")
+	unit, err = registry.Lookup("Dai-X")
+	assert.Nil(t, err, "Failed to look up alias")
+	assert.Equal(t, "xDAI", unit.Name, "Did not receive expected unit")
+}
+
+func TestUnitRegistryRegisterInvalid(t *testing.T) {
+	registry := NewUnitRegistry()
+	assert.NotNil(t, registry.Register(&Unit{Name: "", Decimals: 18}), "Registered unit with empty name")
+	assert.NotNil(t, registry.Register(&Unit{Name: "1foo", Decimals: 18}), "Registered unit with leading digit")
+	assert.NotNil(t, registry.Register(&Unit{Name: "foo bar", Decimals: 18}), "Registered unit with space")
+	assert.NotNil(t, registry.Register(&Unit{Name: "foo", Decimals: -1}), "Registered unit with negative decimals")
+}
+
+func TestUnitRegistryRegisterDuplicate(t *testing.T) {
+	err := RegisterUnit("Shannon", 9)
+	assert.NotNil(t, err, "Registered duplicate unit")
+
+	registry := NewUnitRegistry()
+	assert.Nil(t, registry.Register(&Unit{Name: "foo", Decimals: 1}), "Failed to register unit")
+	assert.NotNil(t, registry.Register(&Unit{Name: "bar", Decimals: 1, Aliases: []string{"FOO"}}), "Registered duplicate alias")
+	_, err = registry.Lookup("bar")
+	assert.NotNil(t, err, "Partially registered unit")
+}
+
+func TestRegisterUnitWithStringToWei(t *testing.T) {
+	err := RegisterUnit("MATIC", 18, "polygon")
+	assert.Nil(t, err, "Failed to register unit")
+	expected, _ := new(big.Int).SetString("1500000000000000000", 10)
+	result, err := StringToWei("1.5 matic")
+	assert.Nil(t, err, "Failed to convert custom unit string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+	result, err = StringToWei("1.5polygon")
+	assert.Nil(t, err, "Failed to convert custom unit alias string to Wei")
+	assert.Equal(t, expected, result, "Did not receive expected result")
+
+	formatter := &WeiFormatter{Unit: "matic", MaxDecimals: -1, NamedUnits: true}
+	output, err := formatter.Format(expected)
+	assert.Nil(t, err, "Failed to format custom unit")
+	assert.Equal(t, "1.5 MATIC", output, "Did not receive expected result")
+}
+
+func TestRegisterUnitWithHyphen(t *testing.T) {
+	err := RegisterUnit("gas-units", 0)
+	assert.Nil(t, err, "Failed to register unit")
+	result, err := StringToWei("21000 gas-units")
+	assert.Nil(t, err, "Failed to convert custom unit string to Wei")
+	assert.Equal(t, big.NewInt(21000), result, "Did not receive expected result")
+	result, err = LocaleGerman.StringToWei("21.000 gas-units")
+	assert.Nil(t, err, "Failed to convert custom unit locale string to Wei")
+	assert.Equal(t, big.NewInt(21000), result, "Did not receive expected result")
+	result, err = StringToSignedWei("-5 gas-units")
+	assert.Nil(t, err, "Failed to convert custom unit signed string to Wei")
+	assert.Equal(t, big.NewInt(-5), result, "Did not receive expected result")
+}
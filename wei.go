@@ -0,0 +1,149 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// WeiEncoding defines the canonical form used when marshalling Wei values
+type WeiEncoding int
+
+const (
+	// WeiEncodingDecimal encodes as a decimal number of Wei, e.g. "1500000000000000000"
+	WeiEncodingDecimal WeiEncoding = iota
+	// WeiEncodingHex encodes as a hex quantity, e.g. "0x14d1120d7b160000"
+	WeiEncodingHex
+	// WeiEncodingUnits encodes in the style of WeiToString, e.g. "1.5 Ether"
+	WeiEncodingUnits
+)
+
+// DefaultWeiEncoding is the encoding used when marshalling Wei values
+var DefaultWeiEncoding = WeiEncodingDecimal
+
+// Wei is a number of Wei that can be marshalled to and from text, JSON and
+// database columns.  On input it accepts anything that StringToWei accepts,
+// including hex quantities such as "0xde0b6b3a7640000"; on output it uses
+// DefaultWeiEncoding
+type Wei big.Int
+
+// NewWei creates a Wei value from a big.Int
+func NewWei(value *big.Int) *Wei {
+	return (*Wei)(new(big.Int).Set(value))
+}
+
+// ParseWei creates a Wei value from a string
+func ParseWei(input string) (*Wei, error) {
+	value, err := parseWei(input)
+	if err != nil {
+		return nil, err
+	}
+	return (*Wei)(value), nil
+}
+
+// Int returns the value as a big.Int
+func (w *Wei) Int() *big.Int {
+	return (*big.Int)(w)
+}
+
+// String returns the value in the default encoding
+func (w Wei) String() string {
+	return w.Encode(DefaultWeiEncoding)
+}
+
+// Encode returns the value in the given encoding
+func (w Wei) Encode(encoding WeiEncoding) string {
+	value := (*big.Int)(&w)
+	switch encoding {
+	case WeiEncodingHex:
+		return "0x" + value.Text(16)
+	case WeiEncodingUnits:
+		return WeiToString(value, true)
+	default:
+		return value.Text(10)
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (w Wei) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (w *Wei) UnmarshalText(input []byte) error {
+	value, err := parseWei(string(input))
+	if err != nil {
+		return err
+	}
+	(*big.Int)(w).Set(value)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.  The value is always encoded as a
+// JSON string, as large numbers are not safe in many JSON implementations
+func (w Wei) MarshalJSON() ([]byte, error) {
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.  Both JSON strings and JSON
+// numbers are accepted
+func (w *Wei) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var str string
+		if err := json.Unmarshal(input, &str); err != nil {
+			return err
+		}
+		return w.UnmarshalText([]byte(str))
+	}
+	if string(input) == "null" {
+		return errors.New("Cannot unmarshal null in to Wei")
+	}
+	return w.UnmarshalText(input)
+}
+
+// Scan implements sql.Scanner
+func (w *Wei) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		return errors.New("Cannot scan NULL in to Wei")
+	case int64:
+		if value < 0 {
+			return errors.New("Value resulted in negative number of Wei")
+		}
+		(*big.Int)(w).SetInt64(value)
+		return nil
+	case []byte:
+		return w.UnmarshalText(value)
+	case string:
+		return w.UnmarshalText([]byte(value))
+	default:
+		return fmt.Errorf("Cannot scan %T in to Wei", src)
+	}
+}
+
+// Value implements driver.Valuer
+func (w Wei) Value() (driver.Value, error) {
+	return w.String(), nil
+}
+
+// parseWei parses a string in any of the forms accepted by Wei
+func parseWei(input string) (*big.Int, error) {
+	return StringToWei(strings.TrimSpace(input))
+}
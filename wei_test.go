@@ -0,0 +1,96 @@
+// Copyright 2017 Orinoco Payments
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etherutils
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type weiHolder struct {
+	Amount Wei  `json:"amount"`
+	Fee    *Wei `json:"fee,omitempty"`
+}
+
+func TestWeiUnmarshalJSON(t *testing.T) {
+	var holder weiHolder
+	err := json.Unmarshal([]byte(`{"amount":"1.5 ether","fee":"0x3b9aca00"}`), &holder)
+	assert.Nil(t, err, "Failed to unmarshal JSON")
+	expected, _ := new(big.Int).SetString("1500000000000000000", 10)
+	assert.Equal(t, expected, holder.Amount.Int(), "Did not receive expected result")
+	assert.Equal(t, big.NewInt(1000000000), holder.Fee.Int(), "Did not receive expected result")
+}
+
+func TestWeiUnmarshalJSONNumber(t *testing.T) {
+	var holder weiHolder
+	err := json.Unmarshal([]byte(`{"amount":1000000000000000000000}`), &holder)
+	assert.Nil(t, err, "Failed to unmarshal JSON")
+	expected, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	assert.Equal(t, expected, holder.Amount.Int(), "Did not receive expected result")
+}
+
+func TestWeiUnmarshalJSONInvalid(t *testing.T) {
+	var holder weiHolder
+	err := json.Unmarshal([]byte(`{"amount":"1000 foo"}`), &holder)
+	assert.NotNil(t, err, "Unmarshalled invalid JSON")
+	err = json.Unmarshal([]byte(`{"amount":"0xzz"}`), &holder)
+	assert.NotNil(t, err, "Unmarshalled invalid JSON")
+}
+
+func TestWeiMarshalJSON(t *testing.T) {
+	amount, _ := ParseWei("1.5 ether")
+	holder := weiHolder{Amount: *amount}
+	result, err := json.Marshal(holder)
+	assert.Nil(t, err, "Failed to marshal JSON")
+	assert.Equal(t, `{"amount":"1500000000000000000"}`, string(result), "Did not receive expected result")
+}
+
+func TestWeiEncodings(t *testing.T) {
+	amount, _ := ParseWei("1.5 ether")
+	assert.Equal(t, "1500000000000000000", amount.Encode(WeiEncodingDecimal), "Did not receive expected result")
+	assert.Equal(t, "0x14d1120d7b160000", amount.Encode(WeiEncodingHex), "Did not receive expected result")
+	assert.Equal(t, "1.5 Ether", amount.Encode(WeiEncodingUnits), "Did not receive expected result")
+}
+
+func TestWeiTextRoundTrip(t *testing.T) {
+	for _, encoding := range []WeiEncoding{WeiEncodingDecimal, WeiEncodingHex, WeiEncodingUnits} {
+		amount, _ := ParseWei("12.345 gwei")
+		var result Wei
+		err := result.UnmarshalText([]byte(amount.Encode(encoding)))
+		assert.Nil(t, err, "Failed to unmarshal text")
+		assert.Equal(t, amount.Int(), result.Int(), "Did not receive expected result")
+	}
+}
+
+func TestWeiScan(t *testing.T) {
+	var result Wei
+	assert.Nil(t, result.Scan([]byte("2 gwei")), "Failed to scan bytes")
+	assert.Equal(t, big.NewInt(2000000000), result.Int(), "Did not receive expected result")
+	assert.Nil(t, result.Scan(int64(5)), "Failed to scan integer")
+	assert.Equal(t, big.NewInt(5), result.Int(), "Did not receive expected result")
+	assert.NotNil(t, result.Scan(nil), "Scanned NULL")
+	assert.NotNil(t, result.Scan(int64(-5)), "Scanned negative value")
+	assert.NotNil(t, result.Scan(1.5), "Scanned float")
+}
+
+func TestWeiValue(t *testing.T) {
+	amount := NewWei(big.NewInt(1000))
+	value, err := amount.Value()
+	assert.Nil(t, err, "Failed to obtain value")
+	assert.Equal(t, "1000", value, "Did not receive expected result")
+}